@@ -6,9 +6,9 @@ import (
 	"log"
 	"log/slog"
 
-	"github.com/hahaclassic/computational-algorithms.git/internal/interpolation"
 	op "github.com/hahaclassic/computational-algorithms.git/internal/operations"
-	"github.com/hahaclassic/computational-algorithms.git/internal/reader"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/interpolation"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/reader"
 )
 
 var (
@@ -53,22 +53,33 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	lagrange, err := interpolation.CreateLagrangePolinomial(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	barycentric, err := interpolation.CreateBarycentric(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Results of the last calculations, their tables of divided differences are shown on request.
+	var newtonResult, hermitResult interpolation.Result
 
 	operation := op.ChooseOperation()
 	for operation != op.Exit {
 		switch operation {
 		case op.CalcNewton:
-			err = op.CalcValueByNewton(newton)
+			newtonResult, err = op.CalcValueByNewton(newton)
 		case op.ShowNewtonTable:
-			newton.PrintDiffTable()
+			newtonResult.PrintDiffTable()
 		case op.CalcHermit:
-			err = op.CalcValueByHermit(hermit)
+			hermitResult, err = op.CalcValueByHermit(hermit)
 		case op.ShowHermitTable:
-			hermit.PrintDiffTable()
+			hermitResult.PrintDiffTable()
 		case op.FindRootValue:
 			err = op.FindRoot(newton, hermit)
 		case op.ComparePolynomials:
-			err = op.Compare(newton, hermit)
+			err = op.Compare(newton, hermit, lagrange, barycentric)
 		case op.SolveSystem:
 			err = op.SolveSystemOfEquations(dataXY, dataYX)
 		case op.ChangeDegree:
@@ -6,8 +6,8 @@ import (
 	"os"
 	"strconv"
 
-	"github.com/hahaclassic/computational-algorithms.git/internal/format"
-	"github.com/hahaclassic/computational-algorithms.git/internal/interpolation"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/format"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/interpolation"
 )
 
 func menu() {
@@ -49,42 +49,32 @@ func ChooseOperation() Operation {
 	return Operation(num)
 }
 
-func CalcValueByNewton(newton *interpolation.Newton) error {
-
-	x, err := format.ReadValue()
-	if err != nil {
-		return err
-	}
-	n, err := format.ReadPolynomialDegree()
-	if err != nil {
-		return err
-	}
-
-	result, err := newton.Calc(x, n)
-	if err != nil {
-		return err
-	}
+func CalcValueByNewton(newton *interpolation.Newton) (interpolation.Result, error) {
+	return calcValue("Newton", newton.Evaluate)
+}
 
-	format.PrintNewtonResult(result)
-	return nil
+func CalcValueByHermit(hermit *interpolation.Hermit) (interpolation.Result, error) {
+	return calcValue("Hermit", hermit.Evaluate)
 }
 
-func CalcValueByHermit(hermit *interpolation.Hermit) error {
+// calcValue() reads x and the degree of the polynomial and prints the value of the chosen method.
+func calcValue(name string, evaluate func(x float64, n int) (interpolation.Result, error)) (interpolation.Result, error) {
 	x, err := format.ReadValue()
 	if err != nil {
-		return err
+		return interpolation.Result{}, err
 	}
 	n, err := format.ReadPolynomialDegree()
 	if err != nil {
-		return err
+		return interpolation.Result{}, err
 	}
-	result, err := hermit.Calc(x, n)
+
+	result, err := evaluate(x, n)
 	if err != nil {
-		return err
+		return interpolation.Result{}, err
 	}
 
-	format.PrintHermitResult(result)
-	return nil
+	format.PrintResult(name, result.Value)
+	return result, nil
 }
 
 func FindRoot(newton *interpolation.Newton, hermit *interpolation.Hermit) error {
@@ -115,45 +105,40 @@ func SetNumDerivatives(hermit *interpolation.Hermit) error {
 	return nil
 }
 
-func Compare(newton *interpolation.Newton, hermit *interpolation.Hermit) error {
+func Compare(newton *interpolation.Newton, hermit *interpolation.Hermit,
+	lagrange *interpolation.Lagrange, barycentric *interpolation.Barycentric) error {
 	x, err := format.ReadValue()
 	if err != nil {
 		return err
 	}
 	degree := 5
-	newtonResults, hermitResults := make([]float64, degree), make([]float64, degree)
-	for i := 0; i < degree; i++ {
-		res, err := newton.Calc(x, i+1)
-		if err != nil {
-			return err
-		}
-		newtonResults[i] = res
-
-		res, err = hermit.Calc(x, i+1)
-		if err != nil {
-			return err
-		}
-		hermitResults[i] = res
+	methods := []func(n int) interpolation.Interpolator{
+		func(n int) interpolation.Interpolator { return interpolation.NewtonAdapter(newton, n) },
+		func(n int) interpolation.Interpolator { return interpolation.HermitAdapter(hermit, n) },
+		func(n int) interpolation.Interpolator { return interpolation.LagrangeAdapter(lagrange, n) },
+		func(n int) interpolation.Interpolator { return interpolation.BarycentricAdapter(barycentric, n) },
 	}
 
-	format.PrintLine(20*5 - 1)
-	fmt.Print("| Polynomial ")
-	for i := 0; i < degree; i++ {
-		fmt.Printf("|        %d       ", i+1)
-	}
-	fmt.Println("|\n|-------------------------------------------------------------------------------------------------|")
-	fmt.Print("| Newton     ")
+	columns := make([]string, degree)
 	for i := 0; i < degree; i++ {
-		fmt.Printf("| %-14f ", newtonResults[i])
-	}
-	fmt.Println("|\n|-------------------------------------------------------------------------------------------------|")
-	fmt.Print("| Hermit     ")
-	for i := 0; i < degree; i++ {
-		fmt.Printf("| %-14f ", hermitResults[i])
+		columns[i] = strconv.Itoa(i + 1)
+	}
+	rows := make([]string, len(methods))
+	results := make([][]float64, len(methods))
+	for i, method := range methods {
+		results[i] = make([]float64, degree)
+		for j := 0; j < degree; j++ {
+			ip := method(j + 1)
+			res, err := ip.Eval(x)
+			if err != nil {
+				return err
+			}
+			rows[i] = ip.Name()
+			results[i][j] = res
+		}
 	}
-	fmt.Println("|")
-	format.PrintLine(20*5 - 1)
-	fmt.Println()
+
+	format.PrintTable("Polynomial", columns, rows, results)
 	return nil
 }
 
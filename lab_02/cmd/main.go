@@ -6,19 +6,29 @@ import (
 	"log"
 	"log/slog"
 
-	"github.com/hahaclassic/computational-algorithms.git/internal/interpolation"
 	op "github.com/hahaclassic/computational-algorithms.git/internal/operations"
-	"github.com/hahaclassic/computational-algorithms.git/internal/reader"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/interpolation"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/reader"
 )
 
 var (
 	mainFile        string
+	rationalMethod  string
+	blendingDegree  int
+	usePchip        bool
+	bsplineDegree   int
+	smoothing       string
 	separator       rune = ','
 	FieldsPerRecord int  = 2
 )
 
 func init() {
 	flag.StringVar(&mainFile, "data", "./data/src.csv", "the main data file")
+	flag.StringVar(&rationalMethod, "rational", "", "the rational interpolation to compare with: floater-hormann, bulirsch-stoer")
+	flag.IntVar(&blendingDegree, "d", 3, "the blending degree of the Floater-Hormann interpolation")
+	flag.BoolVar(&usePchip, "pchip", false, "use the monotone interpolation (PCHIP) instead of the natural spline")
+	flag.IntVar(&bsplineDegree, "bspline", 0, "the degree of the interpolating B-spline to compare with (0 - none)")
+	flag.StringVar(&smoothing, "smooth", "", "the smoothing parameter of the smoothing spline to compare with: a number or gcv (the third column of the data is the uncertainty of y)")
 	flag.Parse()
 
 	if mainFile == "" {
@@ -27,6 +37,9 @@ func init() {
 }
 
 func main() {
+	if smoothing != "" {
+		FieldsPerRecord = 0
+	}
 	data, err := reader.ReadCSVFloatMatrix(mainFile, separator, FieldsPerRecord)
 	if err != nil {
 		log.Fatal(err)
@@ -41,19 +54,48 @@ func main() {
 		log.Fatal(err)
 	}
 
+	var pchip *interpolation.Pchip
+	if usePchip {
+		pchip, err = interpolation.CreatePchip(data)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	rational, err := op.CreateRational(rationalMethod, data, blendingDegree)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	bspline, err := op.CreateBSpline(data, bsplineDegree)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	smoothingSpline, err := op.CreateSmoothingSpline(data, smoothing)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	operation := op.ChooseOperation()
 	for operation != op.Exit {
-		switch operation {
-		case op.CalcValue:
-			err = op.CalcValues(newton, spline)
-		case op.SetupNaturalCond:
-			op.SetNaturalCond(spline)
-		case op.SetupStart:
-			err = op.SetStart(data[0][0], newton, spline)
-		case op.SetupStartEnd:
-			err = op.SetStartEnd(data[0][0], data[len(data)-1][0], newton, spline)
-		case op.ComparePolynomials:
-			err = op.Compare(data, newton, spline)
+		if pchip != nil && operation.IsBoundaryCond() {
+			err = op.ErrNoBoundaryCond
+		} else {
+			switch operation {
+			case op.CalcValue:
+				err = op.CalcValues(newton, op.Curve(spline, pchip), rational, bspline, smoothingSpline)
+			case op.SetupNaturalCond:
+				spline, err = op.SetNaturalCond(spline)
+			case op.SetupStart:
+				spline, err = op.SetStart(data[0][0], newton, spline)
+			case op.SetupStartEnd:
+				spline, err = op.SetStartEnd(data[0][0], data[len(data)-1][0], newton, spline)
+			case op.SetupBoundaryCond:
+				spline, err = op.SetBoundaryCond(spline)
+			case op.ComparePolynomials:
+				err = op.Compare(data, newton, op.Curve(spline, pchip), rational, bspline, smoothingSpline)
+			}
 		}
 		if err != nil {
 			slog.Error(err.Error())
@@ -1,5 +1,23 @@
 package operations
 
+import "errors"
+
+var (
+	ErrUnknownMethod       = errors.New("unknown interpolation method")
+	ErrNoBoundaryCond      = errors.New("the monotone interpolation has no boundary conditions")
+	ErrInvalidBoundaryKind = errors.New("invalid kind of the boundary condition")
+	ErrInvalidSmoothing    = errors.New("the smoothing parameter must be a number or gcv")
+)
+
+// Names of the rational interpolation methods (the -rational flag).
+const (
+	FloaterHormann string = "floater-hormann"
+	BulirschStoer  string = "bulirsch-stoer"
+)
+
+// GCV is the value of the -smooth flag choosing the smoothing parameter by the generalized cross-validation.
+const GCV string = "gcv"
+
 type Operation int
 
 const (
@@ -8,6 +26,7 @@ const (
 	SetupNaturalCond
 	SetupStart
 	SetupStartEnd
+	SetupBoundaryCond
 	ComparePolynomials
 )
 
@@ -18,10 +37,16 @@ func (op Operation) String() string {
 		"Установить естественные краевые условия",
 		"Установить phi''(x0) == P''(x0) (полином Ньютона 3 степ.)",
 		"Установить phi''(x0) == P''(x0) и phi''(xn) == P''(xn)",
+		"Задать краевые условия на каждом конце",
 		"Сравнить результаты в центре и на границах таблицы",
 	}[op]
 }
 
+// IsBoundaryCond() reports whether the operation changes the boundary conditions of the spline.
+func (op Operation) IsBoundaryCond() bool {
+	return op == SetupNaturalCond || op == SetupStart || op == SetupStartEnd || op == SetupBoundaryCond
+}
+
 const header string = `=========================================================================================
 |                  Интерполяция с помощью сплайна и полинома Ньютона                    |
 -----------------------------------------------------------------------------------------
@@ -6,8 +6,8 @@ import (
 	"os"
 	"strconv"
 
-	"github.com/hahaclassic/computational-algorithms.git/internal/format"
-	"github.com/hahaclassic/computational-algorithms.git/internal/interpolation"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/format"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/interpolation"
 )
 
 func menu() {
@@ -46,52 +46,167 @@ func ChooseOperation() Operation {
 	return Operation(num)
 }
 
-func CalcValues(newton *interpolation.Newton, spline *interpolation.Spline) error {
+func CalcValues(newton *interpolation.Newton, curves ...interpolation.Interpolator) error {
 
 	x, err := format.ReadValue()
 	if err != nil {
 		return err
 	}
 
-	newtonResult, err := newton.Calc(x, 3)
+	for _, ip := range methods(newton, curves...) {
+		result, err := ip.Eval(x)
+		if err != nil {
+			return err
+		}
+		format.PrintResult(ip.Name(), result)
+	}
+	return nil
+}
+
+// methods() returns the interpolation methods compared by the program.
+// curves - the spline or the monotone interpolation and the other methods (nil - not compared).
+func methods(newton *interpolation.Newton, curves ...interpolation.Interpolator) []interpolation.Interpolator {
+	ips := []interpolation.Interpolator{interpolation.NewtonAdapter(newton, 3)}
+	for _, curve := range curves {
+		if curve != nil {
+			ips = append(ips, curve)
+		}
+	}
+	return ips
+}
+
+// CreateRational() creates the rational interpolation by its name ("" - none).
+// d - the blending degree of the Floater-Hormann interpolation.
+func CreateRational(name string, points [][]float64, d int) (interpolation.Interpolator, error) {
+	switch name {
+	case "":
+		return nil, nil
+	case FloaterHormann:
+		fh, err := interpolation.CreateFloaterHormann(points, d)
+		if err != nil {
+			return nil, err
+		}
+		return interpolation.FloaterHormannAdapter(fh), nil
+	case BulirschStoer:
+		bs, err := interpolation.CreateBulirschStoer(points)
+		if err != nil {
+			return nil, err
+		}
+		return interpolation.BulirschStoerAdapter(bs, 3), nil
+	}
+	return nil, ErrUnknownMethod
+}
+
+// CreateBSpline() creates the interpolating B-spline of degree p (p == 0 - none).
+func CreateBSpline(points [][]float64, p int) (interpolation.Interpolator, error) {
+	if p == 0 {
+		return nil, nil
+	}
+	bspline, err := interpolation.CreateBSpline(points, p)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return interpolation.BSplineAdapter(bspline), nil
+}
 
-	splineResult := spline.Calc(x)
+// CreateSmoothingSpline() creates the smoothing spline ("" - none, "gcv" - the parameter is chosen by
+// the generalized cross-validation, otherwise - the value of the smoothing parameter).
+func CreateSmoothingSpline(points [][]float64, smoothing string) (interpolation.Interpolator, error) {
+	var spline *interpolation.SmoothingSpline
+	var err error
+	switch smoothing {
+	case "":
+		return nil, nil
+	case GCV:
+		spline, err = interpolation.CreateSmoothingSplineGCV(points)
+	default:
+		var lambda float64
+		lambda, err = strconv.ParseFloat(smoothing, 64)
+		if err != nil {
+			return nil, ErrInvalidSmoothing
+		}
+		spline, err = interpolation.CreateSmoothingSpline(points, lambda)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	format.PrintNewtonResult(newtonResult)
-	format.PrintSplineResult(splineResult)
-	return nil
+	fmt.Printf("Параметр сглаживания: %g, GCV: %g\n", spline.Lambda(), spline.GCV())
+	return interpolation.SmoothingSplineAdapter(spline), nil
+}
+
+// Curve() returns the monotone interpolation if it is selected (pchip != nil), otherwise the spline.
+func Curve(spline *interpolation.Spline, pchip *interpolation.Pchip) interpolation.Interpolator {
+	if pchip != nil {
+		return interpolation.PchipAdapter(pchip)
+	}
+	return interpolation.SplineAdapter(spline)
 }
 
-func SetNaturalCond(spline *interpolation.Spline) {
-	spline.SetBoundaryCond(0, 0)
+func SetNaturalCond(spline *interpolation.Spline) (*interpolation.Spline, error) {
+	return refit(spline, interpolation.NaturalCond(), interpolation.NaturalCond())
 }
 
-func SetStart(startX float64, newton *interpolation.Newton, spline *interpolation.Spline) error {
+func SetStart(startX float64, newton *interpolation.Newton, spline *interpolation.Spline) (*interpolation.Spline, error) {
 	p, err := newton.Derivative2(startX, 3)
 	if err != nil {
-		return err
+		return spline, err
 	}
 
-	spline.SetBoundaryCond(p/2, 0)
-
-	return nil
+	return refit(spline, interpolation.SecondDerivativeCond(p), interpolation.NaturalCond())
 }
 
-func SetStartEnd(startX, endX float64, newton *interpolation.Newton, spline *interpolation.Spline) error {
+func SetStartEnd(startX, endX float64, newton *interpolation.Newton, spline *interpolation.Spline) (*interpolation.Spline, error) {
 	p1, err := newton.Derivative2(startX, 3)
 	if err != nil {
-		return err
+		return spline, err
 	}
 	p2, err := newton.Derivative2(endX, 3)
 	if err != nil {
-		return err
+		return spline, err
 	}
 
-	spline.SetBoundaryCond(p1/2, p2/2)
-	return nil
+	return refit(spline, interpolation.SecondDerivativeCond(p1), interpolation.SecondDerivativeCond(p2))
+}
+
+// SetBoundaryCond() reads the kind of the boundary condition (and its value) for each end and refits the spline.
+func SetBoundaryCond(spline *interpolation.Spline) (*interpolation.Spline, error) {
+	start, err := readBoundaryCond("левом")
+	if err != nil {
+		return spline, err
+	}
+	end, err := readBoundaryCond("правом")
+	if err != nil {
+		return spline, err
+	}
+
+	return refit(spline, start, end)
+}
+
+// refit() returns the spline fitted with the boundary conditions, or the original spline if the fit fails.
+func refit(spline *interpolation.Spline, start, end interpolation.BoundaryCondition) (*interpolation.Spline, error) {
+	fitted, err := spline.FitWith(start, end)
+	if err != nil {
+		return spline, err
+	}
+	return fitted, nil
+}
+
+func readBoundaryCond(end string) (interpolation.BoundaryCondition, error) {
+	kind, err := format.ReadBoundaryKind(end)
+	if err != nil {
+		return interpolation.BoundaryCondition{}, err
+	}
+
+	cond := interpolation.BoundaryCondition{Kind: interpolation.BoundaryKind(kind)}
+	switch cond.Kind {
+	case interpolation.Natural, interpolation.NotAKnot, interpolation.Periodic:
+	case interpolation.SecondDerivative, interpolation.Clamped:
+		cond.Value, err = format.ReadDerivative()
+	default:
+		err = ErrInvalidBoundaryKind
+	}
+	return cond, err
 }
 
 func addPoints(x []float64, start, end float64) []float64 {
@@ -103,47 +218,31 @@ func addPoints(x []float64, start, end float64) []float64 {
 	return x
 }
 
-func Compare(points [][]float64, newton *interpolation.Newton, spline *interpolation.Spline) error {
+func Compare(points [][]float64, newton *interpolation.Newton, curves ...interpolation.Interpolator) error {
 
 	x := []float64{}
 	x = addPoints(x, points[0][0], points[1][0])
 	x = addPoints(x, points[len(points)/2][0], points[len(points)/2+1][0])
 	x = addPoints(x, points[len(points)-2][0], points[len(points)-1][0])
 
-	newtonResults, splineResults := make([]float64, len(x)), make([]float64, len(x))
+	columns := make([]string, len(x))
 	for i := 0; i < len(x); i++ {
-		res, err := newton.Calc(x[i], 3)
+		columns[i] = strconv.FormatFloat(x[i], 'f', 6, 64)
+	}
+
+	ips := methods(newton, curves...)
+	rows := make([]string, len(ips))
+	results := make([][]float64, len(ips))
+	for i, ip := range ips {
+		res, err := interpolation.EvalAll(ip, x)
 		if err != nil {
 			return err
 		}
-		newtonResults[i] = res
-
-		res = spline.Calc(x[i])
-		splineResults[i] = res
+		rows[i] = ip.Name()
+		results[i] = res
 	}
 
 	fmt.Println()
-	format.PrintLine(34*5 - 3)
-	fmt.Print("\n|     x      ")
-	for i := 0; i < 9; i++ {
-		fmt.Printf("| %-14f ", x[i])
-	}
-	fmt.Print("|\n|")
-	format.PrintLine(34*5 - 5)
-	//fmt.Println("|\n|---------------------------------------------------------------------------------------------------------------------------------------------------------------------|")
-	fmt.Print("|\n| Newton     ")
-	for i := 0; i < 9; i++ {
-		fmt.Printf("| %-14f ", newtonResults[i])
-	}
-	fmt.Print("|\n|")
-	format.PrintLine(34*5 - 5)
-	//fmt.Println("|\n|---------------------------------------------------------------------------------------------------------------------------------------------------------------------|")
-	fmt.Print("|\n| Spline     ")
-	for i := 0; i < 9; i++ {
-		fmt.Printf("| %-14f ", splineResults[i])
-	}
-	fmt.Println("|")
-	format.PrintLine(34*5 - 3)
-	fmt.Print("\n\n")
+	format.PrintTable("x", columns, rows, results)
 	return nil
 }
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+
+	op "github.com/hahaclassic/computational-algorithms.git/internal/operations"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/interpolation"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/reader"
+)
+
+var (
+	gridFile        string
+	tableFile       string
+	scatteredFile   string
+	separator       rune = ','
+	FieldsPerRecord int  = 3
+)
+
+func init() {
+	flag.StringVar(&gridFile, "grid", "./data/grid.csv", "the table of z(x, y) on a grid")
+	flag.StringVar(&tableFile, "table", "./data/table3d.csv", "the table of u(x, y, z) sliced by z")
+	flag.StringVar(&scatteredFile, "scattered", "./data/scattered.csv", "the scattered points x, y, z")
+	flag.Parse()
+
+	if gridFile == "" || tableFile == "" || scatteredFile == "" {
+		log.Fatal("Files' names are not specified")
+	}
+}
+
+func main() {
+	gridTable, err := reader.ReadCSVGrid(gridFile, separator)
+	if err != nil {
+		log.Fatal(err)
+	}
+	grid, err := interpolation.CreateGridFromTable(gridTable)
+	if err != nil {
+		log.Fatal(err)
+	}
+	surfaces, err := op.Surfaces(grid)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	zs, slices, err := reader.ReadCSVSlices(tableFile, separator)
+	if err != nil {
+		log.Fatal(err)
+	}
+	table, err := interpolation.CreateTable3DFromSlices(zs, slices)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	points, err := reader.ReadCSVFloatMatrix(scatteredFile, separator, FieldsPerRecord)
+	if err != nil {
+		log.Fatal(err)
+	}
+	scattered, err := op.ScatteredSurfaces(points)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	triangulation, err := interpolation.CreateTriangulation(points)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	operation := op.ChooseOperation()
+	for operation != op.Exit {
+		switch operation {
+		case op.CalcGridValue:
+			err = op.CalcSurfaceValues(surfaces)
+		case op.CalcTableValue:
+			err = op.CalcNewtonValue(table)
+		case op.CompareDegrees:
+			err = op.CompareNewtonDegrees(table)
+		case op.CalcScatteredValue:
+			err = op.CalcSurfaceValues(scattered)
+		case op.CalcTriangulationValue:
+			err = op.CalcSurfaceValues(op.TriangulationSurfaces(triangulation))
+		case op.SaveTriangulation:
+			err = op.WriteTriangulation(triangulation)
+		}
+		if err != nil {
+			slog.Error(err.Error())
+		}
+		operation = op.ChooseOperation()
+	}
+	fmt.Println("Программа завершена.")
+}
@@ -0,0 +1,39 @@
+import math
+import random
+
+def func(x, y):
+    return x**2 + y**2
+
+def func3(x, y, z):
+    return math.exp(2*x - y) * z**2
+
+kind = input("Введите тип таблицы (2 - z(x, y) на сетке, 3 - u(x, y, z) по срезам z, s - z(x, y) в случайных точках): ")
+if kind == "s":
+    count = int(input("Введите количество точек: "))
+    a = float(input("Введите начало диапазона: "))
+    b = float(input("Введите конец диапазона: "))
+else:
+    xs = [float(x) for x in input("Введите узлы x через пробел: ").split()]
+    ys = [float(y) for y in input("Введите узлы y через пробел: ").split()]
+zs = []
+if kind == "3":
+    zs = [float(z) for z in input("Введите узлы z через пробел: ").split()]
+filename = input("Введите название файла: ")
+
+file = open(filename, "w")
+if kind == "s":
+    file.write("x,y,z\n")
+    for i in range(count):
+        x, y = round(random.uniform(a, b), 4), round(random.uniform(a, b), 4)
+        file.write(str(x) + "," + str(y) + "," + str(round(func(x, y), 6)) + "\n")
+elif kind == "3":
+    for z in zs:
+        file.write("z," + str(z) + "\n")
+        file.write("y\\x," + ",".join(str(x) for x in xs) + "\n")
+        for y in ys:
+            file.write(str(y) + "," + ",".join(str(round(func3(x, y, z), 6)) for x in xs) + "\n")
+else:
+    file.write("y\\x," + ",".join(str(x) for x in xs) + "\n")
+    for y in ys:
+        file.write(str(y) + "," + ",".join(str(func(x, y)) for x in xs) + "\n")
+file.close()
@@ -0,0 +1,40 @@
+package operations
+
+// Power is the power of the distance in the Shepard interpolation.
+const Power float64 = 2
+
+// MaxDegree is the largest degree along an axis compared by CompareDegrees.
+const MaxDegree int = 3
+
+type Operation int
+
+const (
+	Exit Operation = iota
+	CalcGridValue
+	CalcTableValue
+	CompareDegrees
+	CalcScatteredValue
+	CalcTriangulationValue
+	SaveTriangulation
+)
+
+func (op Operation) String() string {
+	return []string{
+		"Выход из программы.",
+		"Вычислить z(x, y) по таблице на сетке",
+		"Вычислить u(x, y, z) полиномом Ньютона степеней nx, ny, nz",
+		"Сравнить результаты для разных степеней nx, ny, nz",
+		"Вычислить z(x, y) по нерегулярно расположенным точкам",
+		"Вычислить z(x, y) на триангуляции Делоне",
+		"Сохранить триангуляцию Делоне в файл (.off или .csv)",
+	}[op]
+}
+
+const header string = `=========================================================================================
+|                              Многомерная интерполяция                                 |
+-----------------------------------------------------------------------------------------
+| Операции                                                                              |
+|---------------------------------------------------------------------------------------|`
+
+const line string = "-----------------------------------------------------------------------------------------\n"
+const emptyLine string = "|                                                                                       |\n"
@@ -0,0 +1,182 @@
+package operations
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/format"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/interpolation"
+)
+
+func menu() {
+	fmt.Println(header)
+	for i := CalcGridValue; i <= SaveTriangulation; i++ {
+		fmt.Printf("| %d. %-82s |\n", int(i), i)
+	}
+	fmt.Print(emptyLine)
+	fmt.Printf("| 0. %-82s |\n", Exit)
+	fmt.Print(line)
+}
+
+func ChooseOperation() Operation {
+	menu()
+	var (
+		num int
+		err error
+	)
+
+	scanner := bufio.NewScanner(os.Stdin)
+
+	for {
+		fmt.Printf("Введите номер операции: ")
+		if !scanner.Scan() {
+			return Exit
+		}
+		num, err = strconv.Atoi(scanner.Text())
+		if err != nil {
+			fmt.Println("[ERR]: Неверный номер операции. Введите номер повторно.")
+			continue
+		}
+		if num >= int(Exit) && num <= int(SaveTriangulation) {
+			break
+		}
+		fmt.Println("[ERR]: Неверный номер операции. Введите номер повторно.")
+	}
+
+	return Operation(num)
+}
+
+// Surfaces() returns the interpolation methods of z(x, y) on the grid (the bicubic convolution only on a uniform grid).
+func Surfaces(grid *interpolation.Grid) ([]interpolation.Surface, error) {
+	spline, err := interpolation.CreateSplineSurface(grid)
+	if err != nil {
+		return nil, err
+	}
+
+	surfaces := []interpolation.Surface{interpolation.BilinearAdapter(grid)}
+	if grid.Uniform() {
+		surfaces = append(surfaces, interpolation.BicubicAdapter(grid))
+	}
+	return append(surfaces, interpolation.SplineSurfaceAdapter(spline)), nil
+}
+
+// ScatteredSurfaces() returns the interpolation methods of z(x, y) by scattered points.
+func ScatteredSurfaces(points [][]float64) ([]interpolation.Surface, error) {
+	shepard, err := interpolation.CreateShepard(points, Power)
+	if err != nil {
+		return nil, err
+	}
+	modified, err := interpolation.CreateModifiedShepard(points, 13, 19)
+	if err != nil {
+		return nil, err
+	}
+	surfaces := []interpolation.Surface{
+		interpolation.ShepardAdapter(shepard),
+		interpolation.ModifiedShepardAdapter(modified),
+	}
+
+	for _, kernel := range []interpolation.RBFKernel{interpolation.Gaussian, interpolation.Multiquadric, interpolation.ThinPlate} {
+		rbf, err := interpolation.CreateRBF(points, kernel, 0, 1)
+		if err != nil {
+			return nil, err
+		}
+		surfaces = append(surfaces, interpolation.RBFAdapter(rbf))
+	}
+	return surfaces, nil
+}
+
+// TriangulationSurfaces() returns the interpolation methods of z(x, y) on the triangulation.
+func TriangulationSurfaces(triangulation *interpolation.Triangulation) []interpolation.Surface {
+	return []interpolation.Surface{
+		interpolation.LinearTriangulationAdapter(triangulation),
+		interpolation.CloughTocherAdapter(triangulation),
+	}
+}
+
+// WriteTriangulation() writes the triangulation to the file in the OFF format (.off) or as CSV (otherwise).
+func WriteTriangulation(triangulation *interpolation.Triangulation) error {
+	name, err := format.ReadFileName()
+	if err != nil {
+		return err
+	}
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if filepath.Ext(name) == ".off" {
+		return triangulation.WriteOFF(file)
+	}
+	return triangulation.WriteCSV(file)
+}
+
+func CalcSurfaceValues(surfaces []interpolation.Surface) error {
+	point, err := format.ReadPoint("x", "y")
+	if err != nil {
+		return err
+	}
+
+	for _, surface := range surfaces {
+		result, err := surface.Eval(point[0], point[1])
+		if err != nil {
+			return err
+		}
+		format.PrintResult(surface.Name(), result)
+	}
+	return nil
+}
+
+func CalcNewtonValue(table *interpolation.Table3D) error {
+	point, err := format.ReadPoint("x", "y", "z")
+	if err != nil {
+		return err
+	}
+	degrees, err := format.ReadDegrees("nx", "ny", "nz")
+	if err != nil {
+		return err
+	}
+
+	result, err := table.Newton(point[0], point[1], point[2], degrees[0], degrees[1], degrees[2])
+	if err != nil {
+		return err
+	}
+	format.PrintNewtonResult(result)
+	return nil
+}
+
+// CompareNewtonDegrees() prints u(x, y, z) for the degrees from 1 to MaxDegree along every axis.
+func CompareNewtonDegrees(table *interpolation.Table3D) error {
+	point, err := format.ReadPoint("x", "y", "z")
+	if err != nil {
+		return err
+	}
+
+	columns := make([]string, MaxDegree)
+	for nz := 1; nz <= MaxDegree; nz++ {
+		columns[nz-1] = fmt.Sprintf("nz = %d", nz)
+	}
+
+	rows := []string{}
+	results := [][]float64{}
+	for nx := 1; nx <= MaxDegree; nx++ {
+		for ny := 1; ny <= MaxDegree; ny++ {
+			values := make([]float64, MaxDegree)
+			for nz := 1; nz <= MaxDegree; nz++ {
+				values[nz-1], err = table.Newton(point[0], point[1], point[2], nx, ny, nz)
+				if err != nil {
+					return err
+				}
+			}
+			rows = append(rows, fmt.Sprintf("nx = %d, ny = %d", nx, ny))
+			results = append(results, values)
+		}
+	}
+
+	fmt.Println()
+	format.PrintTable("u(x, y, z)", columns, rows, results)
+	return nil
+}
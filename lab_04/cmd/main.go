@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+
+	op "github.com/hahaclassic/computational-algorithms.git/internal/operations"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/reader"
+)
+
+var (
+	mainFile        string
+	nonlinearFile   string
+	surfaceFile     string
+	methodName      string
+	separator       rune = ','
+	FieldsPerRecord int  = 0
+)
+
+func init() {
+	flag.StringVar(&mainFile, "data", "./data/points.csv", "the points x, y and the optional weight w")
+	flag.StringVar(&nonlinearFile, "nonlinear", "./data/decay.csv", "the points x, y and the optional weight w for the nonlinear models")
+	flag.StringVar(&surfaceFile, "surface", "./data/surface.csv", "the points x, y, z and the optional weight w")
+	flag.StringVar(&methodName, "method", "qr", "the least squares method: normal, qr")
+	flag.Parse()
+
+	if mainFile == "" || nonlinearFile == "" || surfaceFile == "" {
+		log.Fatal("File's name are not specified")
+	}
+}
+
+func main() {
+	method, err := op.ParseMethod(methodName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data, err := reader.ReadCSVFloatMatrix(mainFile, separator, FieldsPerRecord)
+	if err != nil {
+		log.Fatal(err)
+	}
+	nonlinearData, err := reader.ReadCSVFloatMatrix(nonlinearFile, separator, FieldsPerRecord)
+	if err != nil {
+		log.Fatal(err)
+	}
+	surfaceData, err := reader.ReadCSVFloatMatrix(surfaceFile, separator, FieldsPerRecord)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	operation := op.ChooseOperation()
+	for operation != op.Exit {
+		switch operation {
+		case op.FitPolynomial:
+			err = op.FitAndCalc(data, method)
+		case op.CompareDegrees:
+			err = op.CompareFitDegrees(data, method)
+		case op.CompareBases:
+			err = op.CompareOrthogonal(data, method)
+		case op.ChooseDegree:
+			err = op.ChooseFitDegree(data)
+		case op.FitNonlinear:
+			err = op.FitNonlinearModel(nonlinearData)
+		case op.FitSurface:
+			err = op.FitSurfaceAndCalc(surfaceData, method)
+		}
+		if err != nil {
+			slog.Error(err.Error())
+		}
+		operation = op.ChooseOperation()
+	}
+	fmt.Println("Программа завершена.")
+}
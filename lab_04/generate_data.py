@@ -0,0 +1,32 @@
+import math
+import random
+
+def func(x):
+    return 0.5 * x**3 - 2 * x**2 + x + 3 + math.sin(3 * x)
+
+def func2(x, y):
+    return 1 + x - 2 * y + 0.5 * x * y + x**2 - y**2 / 2
+
+kind = input("Введите тип таблицы (1 - y(x), 2 - z(x, y) в случайных точках): ")
+a = float(input("Введите начало диапазона: "))
+b = float(input("Введите конец диапазона: "))
+count = int(input("Введите количество точек: "))
+noise = float(input("Введите среднеквадратичное отклонение шума: "))
+filename = input("Введите название файла: ")
+
+file = open(filename, "w")
+if kind == "2":
+    file.write("x,y,z,w\n")
+    for i in range(count):
+        x, y = random.uniform(a, b), random.uniform(a, b)
+        sigma = noise * random.uniform(0.5, 2)
+        z = func2(x, y) + random.gauss(0, sigma)
+        file.write(str(round(x, 4)) + "," + str(round(y, 4)) + "," + str(round(z, 6)) + "," + str(round(1 / sigma**2, 4)) + "\n")
+else:
+    file.write("x,y,w\n")
+    for i in range(count):
+        x = a + (b - a) * i / (count - 1)
+        sigma = noise * random.uniform(0.5, 2)
+        y = func(x) + random.gauss(0, sigma)
+        file.write(str(round(x, 4)) + "," + str(round(y, 6)) + "," + str(round(1 / sigma**2, 4)) + "\n")
+file.close()
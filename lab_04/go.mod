@@ -0,0 +1,7 @@
+module github.com/hahaclassic/computational-algorithms.git
+
+go 1.21.6
+
+require github.com/hahaclassic/computational-algorithms.git/pkg v0.0.0
+
+replace github.com/hahaclassic/computational-algorithms.git/pkg => ../pkg
@@ -0,0 +1,50 @@
+package operations
+
+import "errors"
+
+var (
+	ErrInvalidMethod = errors.New("the least squares method must be normal or qr")
+	ErrInvalidModel  = errors.New("invalid number of the model")
+)
+
+// MaxDegree is the largest degree of the polynomial compared by CompareDegrees.
+const MaxDegree int = 6
+
+// AutoMaxDegree is the largest degree tried by the automatic choice of the degree.
+const AutoMaxDegree int = 20
+
+// Tolerance is the relative decrease of the residual variance required to increase the degree.
+const Tolerance float64 = 0.01
+
+type Operation int
+
+const (
+	Exit Operation = iota
+	FitPolynomial
+	CompareDegrees
+	CompareBases
+	ChooseDegree
+	FitNonlinear
+	FitSurface
+)
+
+func (op Operation) String() string {
+	return []string{
+		"Выход из программы.",
+		"Построить полином наилучшего приближения степени n и вычислить его значение",
+		"Сравнить погрешности приближения для разных степеней n",
+		"Сравнить приближения степени n в ортогональных базисах",
+		"Выбрать степень приближения автоматически",
+		"Найти параметры нелинейной модели методом Левенберга-Марквардта",
+		"Построить полином наилучшего приближения z(x, y) степени n",
+	}[op]
+}
+
+const header string = `=========================================================================================
+|                           Среднеквадратичное приближение                              |
+-----------------------------------------------------------------------------------------
+| Операции                                                                              |
+|---------------------------------------------------------------------------------------|`
+
+const line string = "-----------------------------------------------------------------------------------------\n"
+const emptyLine string = "|                                                                                       |\n"
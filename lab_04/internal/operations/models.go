@@ -0,0 +1,44 @@
+package operations
+
+import (
+	"math"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/approximation"
+)
+
+// Model is a nonlinear model fitted by the Levenberg-Marquardt method.
+type Model struct {
+	Name     string
+	Params   []string
+	Func     approximation.Model
+	Jacobian approximation.Jacobian // nil - the numeric jacobian
+}
+
+// Models are the models available in FitNonlinearModel().
+var Models = []Model{
+	{
+		Name:   "a exp(-b x) + c",
+		Params: []string{"a", "b", "c"},
+		Func: func(x float64, p []float64) float64 {
+			return p[0]*math.Exp(-p[1]*x) + p[2]
+		},
+		Jacobian: func(x float64, p []float64) []float64 {
+			e := math.Exp(-p[1] * x)
+			return []float64{e, -p[0] * x * e, 1}
+		},
+	},
+	{
+		Name:   "a x^b",
+		Params: []string{"a", "b"},
+		Func: func(x float64, p []float64) float64 {
+			return p[0] * math.Pow(x, p[1])
+		},
+	},
+	{
+		Name:   "a exp(-(x - m)^2 / (2 s^2))",
+		Params: []string{"a", "m", "s"},
+		Func: func(x float64, p []float64) float64 {
+			return p[0] * math.Exp(-(x-p[1])*(x-p[1])/(2*p[2]*p[2]))
+		},
+	},
+}
@@ -0,0 +1,260 @@
+package operations
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/approximation"
+	"github.com/hahaclassic/computational-algorithms.git/pkg/format"
+)
+
+func menu() {
+	fmt.Println(header)
+	for i := FitPolynomial; i <= FitSurface; i++ {
+		fmt.Printf("| %d. %-82s |\n", int(i), i)
+	}
+	fmt.Print(emptyLine)
+	fmt.Printf("| 0. %-82s |\n", Exit)
+	fmt.Print(line)
+}
+
+func ChooseOperation() Operation {
+	menu()
+	var (
+		num int
+		err error
+	)
+
+	scanner := bufio.NewScanner(os.Stdin)
+
+	for {
+		fmt.Printf("Введите номер операции: ")
+		if !scanner.Scan() {
+			return Exit
+		}
+		num, err = strconv.Atoi(scanner.Text())
+		if err != nil {
+			fmt.Println("[ERR]: Неверный номер операции. Введите номер повторно.")
+			continue
+		}
+		if num >= int(Exit) && num <= int(FitSurface) {
+			break
+		}
+		fmt.Println("[ERR]: Неверный номер операции. Введите номер повторно.")
+	}
+
+	return Operation(num)
+}
+
+// ParseMethod() returns the least squares method by the value of the -method flag.
+func ParseMethod(name string) (approximation.Method, error) {
+	switch name {
+	case "normal":
+		return approximation.NormalEquations, nil
+	case "qr":
+		return approximation.QR, nil
+	}
+	return 0, ErrInvalidMethod
+}
+
+// FitAndCalc() fits the polynomial of the degree read from the input, prints its coefficients
+// and the statistics and calculates its value at x.
+func FitAndCalc(points [][]float64, method approximation.Method) error {
+	n, err := format.ReadPolynomialDegree()
+	if err != nil {
+		return err
+	}
+	fit, err := approximation.FitPolynomial(points, n, method)
+	if err != nil {
+		return err
+	}
+
+	coefs := fit.Coefficients()
+	rows := make([]string, len(coefs))
+	values := make([][]float64, len(coefs))
+	for k := 0; k < len(coefs); k++ {
+		rows[k] = fmt.Sprintf("x^%d", k)
+		values[k] = []float64{coefs[k]}
+	}
+	fmt.Printf("\nПолином степени %d (%s):\n", n, method)
+	format.PrintTable("Член", []string{"Коэффициент"}, rows, values)
+	printStats(fit.Stats())
+
+	x, err := format.ReadValue()
+	if err != nil {
+		return err
+	}
+	format.PrintResult("наименьших квадратов", fit.Calc(x))
+	return nil
+}
+
+// CompareFitDegrees() prints the statistics of the polynomials of the degrees from 0 to MaxDegree.
+func CompareFitDegrees(points [][]float64, method approximation.Method) error {
+	rows := []string{}
+	values := [][]float64{}
+	for n := 0; n <= MaxDegree; n++ {
+		fit, err := approximation.FitPolynomial(points, n, method)
+		if err != nil {
+			return err
+		}
+		stats := fit.Stats()
+		rows = append(rows, fmt.Sprintf("n = %d", n))
+		values = append(values, []float64{stats.RMS, stats.Max, stats.R2})
+	}
+
+	fmt.Println()
+	format.PrintTable(method.String(), []string{"RMS", "Max", "R^2"}, rows, values)
+	return nil
+}
+
+// Bases are the orthogonal bases compared by CompareOrthogonal() and ChooseFitDegree().
+var Bases = []approximation.Basis{approximation.Legendre, approximation.Chebyshev, approximation.Gram}
+
+// CompareOrthogonal() prints the statistics of the polynomials of the degree read from the input
+// in the monomial and the orthogonal bases.
+func CompareOrthogonal(points [][]float64, method approximation.Method) error {
+	n, err := format.ReadPolynomialDegree()
+	if err != nil {
+		return err
+	}
+
+	rows := []string{}
+	values := [][]float64{}
+	fit, err := approximation.FitPolynomial(points, n, method)
+	if err == nil {
+		stats := fit.Stats()
+		rows = append(rows, "Monomial")
+		values = append(values, []float64{stats.RMS, stats.Max, stats.R2})
+	} else {
+		fmt.Printf("[ERR]: Базис одночленов: %v\n", err)
+	}
+	for _, basis := range Bases {
+		fit, err := approximation.FitOrthogonal(points, n, basis)
+		if err != nil {
+			return err
+		}
+		stats := fit.Stats()
+		rows = append(rows, basis.String())
+		values = append(values, []float64{stats.RMS, stats.Max, stats.R2})
+	}
+
+	fmt.Println()
+	format.PrintTable(fmt.Sprintf("n = %d", n), []string{"RMS", "Max", "R^2"}, rows, values)
+	return nil
+}
+
+// ChooseFitDegree() chooses the degree in every orthogonal basis by the change of the residual variance
+// and calculates the approximations at x.
+func ChooseFitDegree(points [][]float64) error {
+	x, err := format.ReadValue()
+	if err != nil {
+		return err
+	}
+
+	rows := []string{}
+	values := [][]float64{}
+	for _, basis := range Bases {
+		fit, err := approximation.FitOrthogonalAuto(points, basis, AutoMaxDegree, Tolerance)
+		if err != nil {
+			return err
+		}
+		stats := fit.Stats()
+		rows = append(rows, basis.String())
+		values = append(values, []float64{float64(fit.Degree()), stats.RMS, stats.R2, fit.Calc(x)})
+	}
+
+	fmt.Println()
+	format.PrintTable("Базис", []string{"n", "RMS", "R^2", "p(x)"}, rows, values)
+	return nil
+}
+
+// FitNonlinearModel() fits the model chosen from Models starting from the initial guess read from the input,
+// prints the parameters with their standard errors and calculates the model at x.
+func FitNonlinearModel(points [][]float64) error {
+	names := make([]string, len(Models))
+	for i := 0; i < len(Models); i++ {
+		names[i] = Models[i].Name
+	}
+	num, err := format.ReadModel(names...)
+	if err != nil {
+		return err
+	}
+	if num < 0 || num >= len(Models) {
+		return ErrInvalidModel
+	}
+	model := Models[num]
+
+	fmt.Println("Начальное приближение.")
+	initial, err := format.ReadPoint(model.Params...)
+	if err != nil {
+		return err
+	}
+	fit, err := approximation.FitLevenbergMarquardt(points, model.Func, model.Jacobian, initial)
+	if err != nil {
+		return err
+	}
+
+	params, deviations := fit.Params(), fit.Errors()
+	values := make([][]float64, len(params))
+	for k := 0; k < len(params); k++ {
+		values[k] = []float64{params[k], deviations[k]}
+	}
+	fmt.Printf("\nМодель %s, итераций: %d\n", model.Name, fit.Iterations())
+	format.PrintTable("Параметр", []string{"Значение", "Погрешность"}, model.Params, values)
+	printStats(fit.Stats())
+
+	x, err := format.ReadValue()
+	if err != nil {
+		return err
+	}
+	format.PrintResult("Левенберга-Марквардта", fit.Calc(x))
+	return nil
+}
+
+// FitSurfaceAndCalc() fits the polynomial z(x, y) of the total degree read from the input, prints its coefficients,
+// the residuals at the points and the statistics and calculates its value at (x, y).
+func FitSurfaceAndCalc(points [][]float64, method approximation.Method) error {
+	n, err := format.ReadPolynomialDegree()
+	if err != nil {
+		return err
+	}
+	fit, err := approximation.FitSurface(points, n, method)
+	if err != nil {
+		return err
+	}
+
+	terms, coefs := fit.Terms(), fit.Coefficients()
+	rows := make([]string, len(terms))
+	values := make([][]float64, len(terms))
+	for k := 0; k < len(terms); k++ {
+		rows[k] = fmt.Sprintf("x^%d y^%d", terms[k][0], terms[k][1])
+		values[k] = []float64{coefs[k]}
+	}
+	fmt.Printf("\nПолином степени %d (%s):\n", n, method)
+	format.PrintTable("Член", []string{"Коэффициент"}, rows, values)
+
+	residuals := fit.Residuals()
+	rows = make([]string, len(points))
+	values = make([][]float64, len(points))
+	for i := 0; i < len(points); i++ {
+		rows[i] = strconv.Itoa(i + 1)
+		values[i] = []float64{points[i][0], points[i][1], points[i][2], residuals[i]}
+	}
+	format.PrintTable("Точка", []string{"x", "y", "z", "Невязка"}, rows, values)
+	printStats(fit.Stats())
+
+	point, err := format.ReadPoint("x", "y")
+	if err != nil {
+		return err
+	}
+	format.PrintResult("наименьших квадратов", fit.Calc(point[0], point[1]))
+	return nil
+}
+
+func printStats(stats approximation.Stats) {
+	fmt.Printf("Среднеквадратичное отклонение: %g\n", stats.RMS)
+	fmt.Printf("Максимальное отклонение: %g\n", stats.Max)
+	fmt.Printf("Коэффициент детерминации R^2: %g\n\n", stats.R2)
+}
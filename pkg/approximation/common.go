@@ -0,0 +1,138 @@
+// Package approximation contains the least squares approximation of tabulated functions.
+package approximation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/linalg"
+)
+
+var (
+	ErrNotEnoughInputData      = errors.New("not enough input data") // decrease the degree of the polynomial or increase the number of input points
+	ErrInvalidPolynomialDegree = errors.New("invalid polynomial degree")
+	ErrInvalidWeight           = errors.New("the weights of the points must be non-negative")
+	ErrInvalidMethod           = errors.New("unknown least squares method")
+	ErrInvalidTolerance        = errors.New("the tolerance must be from 0 to 1")
+	ErrInvalidParams           = errors.New("the model is not defined for the parameters")
+	ErrNotConverged            = errors.New("the iterations did not converge")
+	ErrSingularSystem          = linalg.ErrSingularSystem
+)
+
+const UndefNum float64 = -1
+
+// Method is the way to solve the linear least squares problem.
+type Method int
+
+const (
+	NormalEquations Method = iota // the system (A^T W A) c = A^T W y, fast but squares the condition number
+	QR                            // the Householder QR decomposition of W^(1/2) A, numerically stable
+)
+
+func (m Method) String() string {
+	names := []string{"Normal equations", "QR"}
+	if m < 0 || int(m) >= len(names) {
+		return "unknown"
+	}
+	return names[m]
+}
+
+// Stats is the quality of the approximation, all the values are weighted.
+type Stats struct {
+	RMS float64 // sqrt(sum(wi ri^2) / sum(wi)), ri = yi - f(xi)
+	Max float64 // max |ri| over the points with wi > 0
+	R2  float64 // the coefficient of determination 1 - sum(wi ri^2) / sum(wi (yi - mean)^2)
+}
+
+// solveWeighted() finds c minimizing sum(wi (sum(a[i][j] c[j]) - y[i])^2).
+func solveWeighted(a [][]float64, y, w []float64, method Method) ([]float64, error) {
+	n := len(a[0])
+	switch method {
+	case NormalEquations:
+		lhs := make([][]float64, n)
+		rhs := make([]float64, n)
+		for j := 0; j < n; j++ {
+			lhs[j] = make([]float64, n)
+		}
+		for i := 0; i < len(a); i++ {
+			for j := 0; j < n; j++ {
+				for k := 0; k < n; k++ {
+					lhs[j][k] += w[i] * a[i][j] * a[i][k]
+				}
+				rhs[j] += w[i] * a[i][j] * y[i]
+			}
+		}
+		return linalg.Solve(lhs, rhs)
+	case QR:
+		scaled := make([][]float64, len(a))
+		rhs := make([]float64, len(a))
+		for i := 0; i < len(a); i++ {
+			root := math.Sqrt(w[i])
+			scaled[i] = make([]float64, n)
+			for j := 0; j < n; j++ {
+				scaled[i][j] = root * a[i][j]
+			}
+			rhs[i] = root * y[i]
+		}
+		return linalg.SolveLeastSquares(scaled, rhs)
+	}
+	return nil, ErrInvalidMethod
+}
+
+// statistics() calculates the residuals yi - f(xi) and the statistics of the approximation.
+func statistics(y, w, values []float64) ([]float64, Stats) {
+	residuals := make([]float64, len(y))
+	var stats Stats
+	var sumW, sumWY float64
+	for i := 0; i < len(y); i++ {
+		sumW += w[i]
+		sumWY += w[i] * y[i]
+	}
+	mean := sumWY / sumW
+
+	var ssRes, ssTot float64
+	for i := 0; i < len(y); i++ {
+		residuals[i] = y[i] - values[i]
+		ssRes += w[i] * residuals[i] * residuals[i]
+		ssTot += w[i] * (y[i] - mean) * (y[i] - mean)
+		if w[i] > 0 {
+			stats.Max = math.Max(stats.Max, math.Abs(residuals[i]))
+		}
+	}
+	stats.RMS = math.Sqrt(ssRes / sumW)
+	stats.R2 = 1
+	if ssTot > 0 {
+		stats.R2 = 1 - ssRes/ssTot
+	}
+	return residuals, stats
+}
+
+// weightedData() splits the points into the values of the arguments, y and the weights.
+// points[i][:dim] - the arguments, points[i][dim] - y, points[i][dim+1] - the weight (optional, 1 by default).
+// At least minPoints points with positive weights are required.
+func weightedData(points [][]float64, dim, minPoints int) ([][]float64, []float64, []float64, error) {
+	args := make([][]float64, len(points))
+	y := make([]float64, len(points))
+	w := make([]float64, len(points))
+	var positive int
+	for i := 0; i < len(points); i++ {
+		if len(points[i]) < dim+1 {
+			return nil, nil, nil, ErrNotEnoughInputData
+		}
+		args[i] = append([]float64{}, points[i][:dim]...)
+		y[i], w[i] = points[i][dim], 1
+		if len(points[i]) > dim+1 {
+			w[i] = points[i][dim+1]
+		}
+		if w[i] < 0 || math.IsNaN(w[i]) {
+			return nil, nil, nil, ErrInvalidWeight
+		}
+		if w[i] > 0 {
+			positive++
+		}
+	}
+	if positive < minPoints {
+		return nil, nil, nil, ErrNotEnoughInputData
+	}
+	return args, y, w, nil
+}
@@ -0,0 +1,286 @@
+package approximation
+
+import (
+	"math"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/linalg"
+)
+
+// Model is the function y = f(x, params) with the parameters fitted to the data.
+type Model func(x float64, params []float64) float64
+
+// Jacobian calculates the partial derivatives of the model df/dparams[k] at x.
+type Jacobian func(x float64, params []float64) []float64
+
+const (
+	maxIterations  int     = 200
+	tolerance      float64 = 1e-10
+	gradientTol    float64 = 1e-10 // the cosine between the residuals and the columns of J at the minimum
+	initialDamping float64 = 1e-3  // the damping relative to the largest diagonal element of J^T W J
+	maxDamping     float64 = 1e16
+)
+
+// NonlinearFit is the set of the parameters of a model minimizing sum(wi (yi - f(xi, params))^2).
+type NonlinearFit struct {
+	model      Model
+	params     []float64
+	covariance [][]float64
+	iterations int
+	residuals  []float64
+	stats      Stats
+}
+
+// FitLevenbergMarquardt() fits the parameters of the model to the points by the Levenberg-Marquardt method
+// starting from the initial guess. jacobian == nil - the derivatives are calculated by the central differences.
+// The points are the same as in FitPolynomial(), more points with positive weights than parameters are required.
+// The iterations stop when an accepted step changes the sum of squares or the parameters by less than the tolerance,
+// or the residuals are orthogonal to the columns of the jacobian (the gradient vanishes).
+// Returns ErrNotConverged if no decreasing step is found or the iterations are exhausted,
+// ErrInvalidParams if the jacobian does not return a derivative for every parameter.
+func FitLevenbergMarquardt(points [][]float64, model Model, jacobian Jacobian, initial []float64) (*NonlinearFit, error) {
+	if len(initial) == 0 {
+		return nil, ErrInvalidParams
+	}
+	args, y, w, err := weightedData(points, 1, len(initial)+1)
+	if err != nil {
+		return nil, err
+	}
+	if jacobian == nil {
+		jacobian = numericJacobian(model)
+	}
+	x := make([]float64, len(args))
+	for i := 0; i < len(args); i++ {
+		x[i] = args[i][0]
+	}
+
+	params := append([]float64{}, initial...)
+	chi2, err := weightedSquares(model, x, y, w, params)
+	if err != nil {
+		return nil, err
+	}
+	damping := initialDamping
+	converged := false
+
+	fit := &NonlinearFit{model: model}
+	for fit.iterations < maxIterations && !converged {
+		fit.iterations++
+		a, g, err := normalSystem(jacobian, model, x, y, w, params)
+		if err != nil {
+			return nil, err
+		}
+		if orthogonal(a, g, chi2) {
+			converged = true
+			break
+		}
+
+		// The step is decreased by increasing the damping until the sum of squares decreases.
+		for {
+			step, err := dampedStep(a, g, damping)
+			if err == nil {
+				next := make([]float64, len(params))
+				for k := 0; k < len(params); k++ {
+					next[k] = params[k] + step[k]
+				}
+				nextChi2, err := weightedSquares(model, x, y, w, next)
+				if err == nil && nextChi2 <= chi2 {
+					// The small change means convergence only if the linear model predicts the decrease,
+					// otherwise the step is small because of the damping, and the jacobian can be wrong.
+					predicted := 2*dot(step, g) - quadratic(a, step)
+					converged = chi2-nextChi2 >= 0.25*predicted &&
+						(chi2-nextChi2 <= tolerance*chi2 || smallStep(step, params))
+					params, chi2 = next, nextChi2
+					damping /= 10
+					break
+				}
+			}
+			damping *= 10
+			if damping > maxDamping {
+				return nil, ErrNotConverged
+			}
+		}
+	}
+	if !converged {
+		return nil, ErrNotConverged
+	}
+
+	a, _, err := normalSystem(jacobian, model, x, y, w, params)
+	if err != nil {
+		return nil, err
+	}
+	covariance, err := linalg.Inverse(a)
+	if err != nil {
+		return nil, err
+	}
+	dof := -len(params)
+	for i := 0; i < len(w); i++ {
+		if w[i] > 0 {
+			dof++
+		}
+	}
+	// Without the degrees of freedom the residual variance is not defined, the covariance is left unscaled.
+	if dof > 0 {
+		for i := 0; i < len(covariance); i++ {
+			for j := 0; j < len(covariance); j++ {
+				covariance[i][j] *= chi2 / float64(dof)
+			}
+		}
+	}
+
+	values := make([]float64, len(x))
+	for i := 0; i < len(x); i++ {
+		values[i] = model(x[i], params)
+	}
+	fit.params, fit.covariance = params, covariance
+	fit.residuals, fit.stats = statistics(y, w, values)
+	return fit, nil
+}
+
+// normalSystem() calculates J^T W J and J^T W r, r - the residuals, J - the jacobian at the points.
+// Returns ErrInvalidParams if the jacobian returns the wrong number of the derivatives.
+func normalSystem(jacobian Jacobian, model Model, x, y, w, params []float64) ([][]float64, []float64, error) {
+	n := len(params)
+	a := make([][]float64, n)
+	for k := 0; k < n; k++ {
+		a[k] = make([]float64, n)
+	}
+	g := make([]float64, n)
+	for i := 0; i < len(x); i++ {
+		if w[i] == 0 {
+			continue
+		}
+		d := jacobian(x[i], params)
+		if len(d) != n {
+			return nil, nil, ErrInvalidParams
+		}
+		r := y[i] - model(x[i], params)
+		for k := 0; k < n; k++ {
+			for l := 0; l < n; l++ {
+				a[k][l] += w[i] * d[k] * d[l]
+			}
+			g[k] += w[i] * d[k] * r
+		}
+	}
+	return a, g, nil
+}
+
+// orthogonal() reports whether the gradient J^T W r is negligible: |g[k]| <= gradientTol * ||Jk|| * ||r||
+// for every column Jk of the jacobian (the norms are weighted). The exact fit with chi2 == 0 is orthogonal too.
+func orthogonal(a [][]float64, g []float64, chi2 float64) bool {
+	for k := 0; k < len(g); k++ {
+		if math.Abs(g[k]) > gradientTol*math.Sqrt(a[k][k]*chi2) {
+			return false
+		}
+	}
+	return true
+}
+
+// dampedStep() solves (A + damping * max(diag(A)) * I) step = g.
+func dampedStep(a [][]float64, g []float64, damping float64) ([]float64, error) {
+	var scale float64
+	for k := 0; k < len(a); k++ {
+		scale = math.Max(scale, a[k][k])
+	}
+	damped := make([][]float64, len(a))
+	for k := 0; k < len(a); k++ {
+		damped[k] = append([]float64{}, a[k]...)
+		damped[k][k] += damping * scale
+	}
+	return linalg.Solve(damped, g)
+}
+
+// quadratic() calculates v^T A v.
+func quadratic(a [][]float64, v []float64) float64 {
+	var result float64
+	for k := 0; k < len(v); k++ {
+		result += v[k] * dot(a[k], v)
+	}
+	return result
+}
+
+// weightedSquares() calculates sum(wi (yi - f(xi, params))^2), ErrInvalidParams if the model is not defined.
+func weightedSquares(model Model, x, y, w, params []float64) (float64, error) {
+	var result float64
+	for i := 0; i < len(x); i++ {
+		if w[i] == 0 {
+			continue
+		}
+		r := y[i] - model(x[i], params)
+		result += w[i] * r * r
+	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return UndefNum, ErrInvalidParams
+	}
+	return result, nil
+}
+
+// smallStep() reports whether the step changes every parameter by less than the relative tolerance.
+func smallStep(step, params []float64) bool {
+	for k := 0; k < len(step); k++ {
+		if math.Abs(step[k]) > math.Sqrt(tolerance)*(math.Abs(params[k])+math.Sqrt(tolerance)) {
+			return false
+		}
+	}
+	return true
+}
+
+// numericJacobian() returns the jacobian of the model calculated by the central differences.
+func numericJacobian(model Model) Jacobian {
+	return func(x float64, params []float64) []float64 {
+		result := make([]float64, len(params))
+		shifted := append([]float64{}, params...)
+		for k := 0; k < len(params); k++ {
+			h := 1e-6 * math.Max(math.Abs(params[k]), 1)
+			shifted[k] = params[k] + h
+			right := model(x, shifted)
+			shifted[k] = params[k] - h
+			left := model(x, shifted)
+			shifted[k] = params[k]
+			result[k] = (right - left) / (2 * h)
+		}
+		return result
+	}
+}
+
+// Calc() calculates f(x, params).
+func (f *NonlinearFit) Calc(x float64) float64 {
+	return f.model(x, f.params)
+}
+
+// Params() returns a copy of the fitted parameters.
+func (f *NonlinearFit) Params() []float64 {
+	return append([]float64{}, f.params...)
+}
+
+// Covariance() returns a copy of the covariance matrix of the parameters (J^T W J)^-1 * s^2,
+// s^2 = sum(wi ri^2) / (m - p) - the residual variance, m - the number of the points with positive weights.
+func (f *NonlinearFit) Covariance() [][]float64 {
+	result := make([][]float64, len(f.covariance))
+	for i := 0; i < len(f.covariance); i++ {
+		result[i] = append([]float64{}, f.covariance[i]...)
+	}
+	return result
+}
+
+// Errors() returns the standard errors of the parameters, the square roots of the diagonal of the covariance.
+func (f *NonlinearFit) Errors() []float64 {
+	result := make([]float64, len(f.params))
+	for k := 0; k < len(result); k++ {
+		result[k] = math.Sqrt(f.covariance[k][k])
+	}
+	return result
+}
+
+// Iterations() returns the number of the iterations made.
+func (f *NonlinearFit) Iterations() int {
+	return f.iterations
+}
+
+// Residuals() returns a copy of the residuals yi - f(xi, params) in the order of the points.
+func (f *NonlinearFit) Residuals() []float64 {
+	return append([]float64{}, f.residuals...)
+}
+
+// Stats() returns the statistics of the approximation.
+func (f *NonlinearFit) Stats() Stats {
+	return f.stats
+}
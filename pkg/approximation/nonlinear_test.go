@@ -0,0 +1,66 @@
+package approximation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenbergMarquardtExponential(t *testing.T) {
+	model := func(x float64, p []float64) float64 {
+		return p[0]*math.Exp(-p[1]*x) + p[2]
+	}
+	jacobian := func(x float64, p []float64) []float64 {
+		e := math.Exp(-p[1] * x)
+		return []float64{e, -p[0] * x * e, 1}
+	}
+	params := []float64{3, 0.7, 1}
+	points := make([][]float64, 40)
+	for i := 0; i < len(points); i++ {
+		x := float64(i) / 8
+		points[i] = []float64{x, model(x, params)}
+	}
+
+	for _, initial := range [][]float64{{1, 1, 0}, {10, 0.1, 0}, {0.1, 5, -3}} {
+		for _, j := range []Jacobian{jacobian, nil} {
+			fit, err := FitLevenbergMarquardt(points, model, j, initial)
+			if err != nil {
+				t.Fatalf("initial %v: %v", initial, err)
+			}
+			for k, p := range fit.Params() {
+				if math.Abs(p-params[k]) > 1e-6*math.Abs(params[k]) {
+					t.Fatalf("initial %v: params %v, expected %v", initial, fit.Params(), params)
+				}
+			}
+			if fit.Stats().RMS > 1e-8 {
+				t.Fatalf("initial %v: RMS %g on the exact data", initial, fit.Stats().RMS)
+			}
+		}
+	}
+}
+
+func TestLevenbergMarquardtWrongJacobian(t *testing.T) {
+	model := func(x float64, p []float64) float64 {
+		return p[0] * math.Exp(p[1]*x)
+	}
+	points := make([][]float64, 20)
+	for i := 0; i < len(points); i++ {
+		x := float64(i) / 4
+		points[i] = []float64{x, 2*math.Exp(-0.5*x) + 0.01*math.Sin(7*x)}
+	}
+
+	// The signs of the derivatives are wrong, no step along the direction decreases the sum of squares.
+	wrong := func(x float64, p []float64) []float64 {
+		e := math.Exp(p[1] * x)
+		return []float64{-e, -p[0] * x * e}
+	}
+	if _, err := FitLevenbergMarquardt(points, model, wrong, []float64{1, -0.2}); err != ErrNotConverged {
+		t.Fatalf("the wrong jacobian: %v, expected %v", err, ErrNotConverged)
+	}
+
+	short := func(x float64, p []float64) []float64 {
+		return []float64{math.Exp(p[1] * x)}
+	}
+	if _, err := FitLevenbergMarquardt(points, model, short, []float64{1, -0.2}); err != ErrInvalidParams {
+		t.Fatalf("the jacobian without a derivative: %v, expected %v", err, ErrInvalidParams)
+	}
+}
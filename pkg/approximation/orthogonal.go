@@ -0,0 +1,336 @@
+package approximation
+
+import "math"
+
+// Basis is the family of orthogonal polynomials of the approximation.
+type Basis int
+
+const (
+	Legendre  Basis = iota // Pk(t), orthogonal on [-1, 1]
+	Chebyshev              // Tk(t), orthogonal on [-1, 1] with the weight 1 / sqrt(1 - t^2)
+	Gram                   // orthogonal on the points with their weights (Forsythe)
+)
+
+func (b Basis) String() string {
+	names := []string{"Legendre", "Chebyshev", "Gram"}
+	if b < 0 || int(b) >= len(names) {
+		return "unknown"
+	}
+	return names[b]
+}
+
+// OrthogonalFit is the least squares approximation p(x) = sum(ck Fk(t)) by the orthogonal polynomials Fk of
+// t = (2x - (xmin + xmax)) / (xmax - xmin), the segment [xmin, xmax] of the points is mapped to [-1, 1].
+// The degree can be increased by Increase() without fitting from the beginning.
+type OrthogonalFit struct {
+	basis        Basis
+	center, half float64
+	t, y, w      []float64
+	distinct     int // the number of the distinct nodes with positive weights
+	coefs        []float64
+	values       []float64 // p(xi)
+
+	// Gram: Fk+1(t) = (t - alpha[k]) Fk(t) - beta[k] Fk-1(t), the values and the norms of Fk at the points.
+	alpha, beta []float64
+	prev, last  []float64
+	norms       []float64
+
+	// Legendre and Chebyshev: W^(1/2) A = QR built by the columns, q[k] - the orthonormal columns,
+	// r[k] - the column k of R, qty[k] - the projection of W^(1/2) y on q[k].
+	q, r [][]float64
+	qty  []float64
+
+	residuals []float64
+	stats     Stats
+}
+
+// FitOrthogonal() fits the polynomial of the given degree in the basis to the points by the weighted least squares.
+// The points are the same as in FitPolynomial().
+// At least degree + 1 distinct nodes with positive weights are required.
+func FitOrthogonal(points [][]float64, degree int, basis Basis) (*OrthogonalFit, error) {
+	if degree < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	fit, err := createOrthogonal(points, basis)
+	if err != nil {
+		return nil, err
+	}
+	for fit.Degree() < degree {
+		if err = fit.Increase(); err != nil {
+			return nil, err
+		}
+	}
+	return fit, nil
+}
+
+// FitOrthogonalAuto() selects the degree from 0 to maxDegree by the change of the residual variance
+// s^2(n) = sum(wi ri^2) / (m - n - 1), m - the number of the points with positive weights.
+// The degree is increased while s^2 decreases by more than the relative tolerance tol; one more degree is tried
+// before stopping, since for symmetric data every second coefficient can be close to zero.
+func FitOrthogonalAuto(points [][]float64, basis Basis, maxDegree int, tol float64) (*OrthogonalFit, error) {
+	if maxDegree < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	if tol < 0 || tol >= 1 {
+		return nil, ErrInvalidTolerance
+	}
+	fit, err := createOrthogonal(points, basis)
+	if err != nil {
+		return nil, err
+	}
+
+	best, bestDegree := fit.variance(), 0
+	for fit.Degree() < maxDegree && fit.Degree()+2 < fit.distinct && fit.Degree()-bestDegree < 2 {
+		if err = fit.Increase(); err != nil {
+			return nil, err
+		}
+		if v := fit.variance(); v < best*(1-tol) {
+			best, bestDegree = v, fit.Degree()
+		}
+	}
+	if fit.Degree() == bestDegree {
+		return fit, nil
+	}
+	return FitOrthogonal(points, bestDegree, basis)
+}
+
+// createOrthogonal() creates the approximation of degree 0 by the weighted mean of y.
+func createOrthogonal(points [][]float64, basis Basis) (*OrthogonalFit, error) {
+	if basis < Legendre || basis > Gram {
+		return nil, ErrInvalidMethod
+	}
+	args, y, w, err := weightedData(points, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	xmin, xmax := args[0][0], args[0][0]
+	for i := 0; i < len(args); i++ {
+		xmin, xmax = math.Min(xmin, args[i][0]), math.Max(xmax, args[i][0])
+	}
+	fit := &OrthogonalFit{
+		basis:  basis,
+		center: (xmin + xmax) / 2,
+		half:   (xmax - xmin) / 2,
+		t:      make([]float64, len(args)),
+		y:      y,
+		w:      w,
+		values: make([]float64, len(args)),
+		prev:   make([]float64, len(args)),
+		last:   make([]float64, len(args)),
+	}
+	if fit.half == 0 {
+		fit.half = 1
+	}
+
+	nodes := make(map[float64]bool)
+	var sumW, sumWY float64
+	for i := 0; i < len(args); i++ {
+		fit.t[i] = fit.mapped(args[i][0])
+		fit.last[i] = 1
+		if w[i] > 0 {
+			nodes[args[i][0]] = true
+		}
+		sumW += w[i]
+		sumWY += w[i] * y[i]
+	}
+	fit.distinct = len(nodes)
+	fit.coefs = []float64{sumWY / sumW}
+	fit.norms = []float64{sumW}
+	for i := 0; i < len(args); i++ {
+		fit.values[i] = fit.coefs[0]
+	}
+	if basis != Gram {
+		if err = fit.appendColumn(0); err != nil {
+			return nil, err
+		}
+	}
+	fit.residuals, fit.stats = statistics(y, w, fit.values)
+	return fit, nil
+}
+
+// Increase() increases the degree of the approximation by 1 without fitting from the beginning.
+// The Gram basis adds one coefficient and keeps the others. Legendre and Chebyshev add a column to the QR
+// decomposition and recalculate the coefficients by the back substitution, the earlier columns are kept.
+func (f *OrthogonalFit) Increase() error {
+	n := f.Degree() + 1
+	if n >= f.distinct {
+		return ErrNotEnoughInputData
+	}
+
+	if f.basis == Gram {
+		f.increaseGram()
+	} else {
+		if err := f.appendColumn(n); err != nil {
+			return err
+		}
+		f.coefs = make([]float64, n+1)
+		for k := n; k >= 0; k-- {
+			sum := f.qty[k]
+			for j := k + 1; j <= n; j++ {
+				sum -= f.r[j][k] * f.coefs[j]
+			}
+			f.coefs[k] = sum / f.r[k][k]
+		}
+		for i := 0; i < len(f.t); i++ {
+			f.values[i] = dot(f.polynomials(f.t[i], n), f.coefs)
+		}
+	}
+	f.residuals, f.stats = statistics(f.y, f.w, f.values)
+	return nil
+}
+
+// appendColumn() orthogonalizes the column W^(1/2) Fn(t) against q[0], ..., q[n-1] by the modified Gram-Schmidt.
+// The orthogonalization is repeated once, since a single pass loses the orthogonality for the ill-conditioned columns.
+func (f *OrthogonalFit) appendColumn(n int) error {
+	column := make([]float64, len(f.t))
+	var scale float64
+	for i := 0; i < len(f.t); i++ {
+		column[i] = math.Sqrt(f.w[i]) * f.polynomials(f.t[i], n)[n]
+		scale += column[i] * column[i]
+	}
+
+	r := make([]float64, n+1)
+	for pass := 0; pass < 2; pass++ {
+		for k := 0; k < n; k++ {
+			projection := dot(f.q[k], column)
+			r[k] += projection
+			for i := 0; i < len(column); i++ {
+				column[i] -= projection * f.q[k][i]
+			}
+		}
+	}
+	r[n] = math.Sqrt(dot(column, column))
+	if r[n] <= 1e-13*math.Sqrt(scale) {
+		return ErrSingularSystem
+	}
+
+	var projection float64
+	for i := 0; i < len(column); i++ {
+		column[i] /= r[n]
+		projection += column[i] * math.Sqrt(f.w[i]) * f.y[i]
+	}
+	f.q = append(f.q, column)
+	f.r = append(f.r, r)
+	f.qty = append(f.qty, projection)
+	return nil
+}
+
+// increaseGram() adds the next polynomial of the three-term recurrence and its coefficient.
+// The coefficient is calculated by the current residuals (the modified Gram-Schmidt) for the stability.
+func (f *OrthogonalFit) increaseGram() {
+	n := f.Degree()
+	var alpha, beta float64
+	for i := 0; i < len(f.t); i++ {
+		alpha += f.w[i] * f.t[i] * f.last[i] * f.last[i]
+	}
+	alpha /= f.norms[n]
+	if n > 0 {
+		beta = f.norms[n] / f.norms[n-1]
+	}
+
+	var norm, projection float64
+	for i := 0; i < len(f.t); i++ {
+		next := (f.t[i]-alpha)*f.last[i] - beta*f.prev[i]
+		f.prev[i], f.last[i] = f.last[i], next
+		norm += f.w[i] * next * next
+		projection += f.w[i] * (f.y[i] - f.values[i]) * next
+	}
+	c := projection / norm
+	for i := 0; i < len(f.t); i++ {
+		f.values[i] += c * f.last[i]
+	}
+
+	f.alpha = append(f.alpha, alpha)
+	f.beta = append(f.beta, beta)
+	f.norms = append(f.norms, norm)
+	f.coefs = append(f.coefs, c)
+}
+
+// Calc() calculates p(x).
+func (f *OrthogonalFit) Calc(x float64) float64 {
+	return dot(f.polynomials(f.mapped(x), f.Degree()), f.coefs)
+}
+
+// polynomials() calculates F0(t), ..., Fn(t).
+func (f *OrthogonalFit) polynomials(t float64, n int) []float64 {
+	result := make([]float64, n+1)
+	result[0] = 1
+	for k := 0; k < n; k++ {
+		var prev float64
+		if k > 0 {
+			prev = result[k-1]
+		}
+		switch f.basis {
+		case Legendre:
+			result[k+1] = (float64(2*k+1)*t*result[k] - float64(k)*prev) / float64(k+1)
+		case Chebyshev:
+			result[k+1] = 2*t*result[k] - prev
+			if k == 0 {
+				result[k+1] = t
+			}
+		default:
+			result[k+1] = (t-f.alpha[k])*result[k] - f.beta[k]*prev
+		}
+	}
+	return result
+}
+
+// mapped() maps x from [xmin, xmax] to t from [-1, 1].
+func (f *OrthogonalFit) mapped(x float64) float64 {
+	return (x - f.center) / f.half
+}
+
+// variance() calculates the residual variance s^2 = sum(wi ri^2) / (m - n - 1).
+func (f *OrthogonalFit) variance() float64 {
+	var sum float64
+	var m int
+	for i := 0; i < len(f.y); i++ {
+		sum += f.w[i] * f.residuals[i] * f.residuals[i]
+		if f.w[i] > 0 {
+			m++
+		}
+	}
+	if m <= f.Degree()+1 {
+		return math.Inf(1)
+	}
+	return sum / float64(m-f.Degree()-1)
+}
+
+// Degree() returns the degree of the polynomial.
+func (f *OrthogonalFit) Degree() int {
+	return len(f.coefs) - 1
+}
+
+// Basis() returns the basis of the approximation.
+func (f *OrthogonalFit) Basis() Basis {
+	return f.basis
+}
+
+// Interval() returns the segment of x mapped to [-1, 1].
+func (f *OrthogonalFit) Interval() (float64, float64) {
+	return f.center - f.half, f.center + f.half
+}
+
+// Coefficients() returns a copy of the coefficients c0, c1, ..., cn in the basis.
+func (f *OrthogonalFit) Coefficients() []float64 {
+	return append([]float64{}, f.coefs...)
+}
+
+// Residuals() returns a copy of the residuals yi - p(xi) in the order of the points.
+func (f *OrthogonalFit) Residuals() []float64 {
+	return append([]float64{}, f.residuals...)
+}
+
+// Stats() returns the statistics of the approximation.
+func (f *OrthogonalFit) Stats() Stats {
+	return f.stats
+}
+
+func dot(a, b []float64) float64 {
+	var result float64
+	for i := 0; i < len(a); i++ {
+		result += a[i] * b[i]
+	}
+	return result
+}
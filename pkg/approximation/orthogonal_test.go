@@ -0,0 +1,76 @@
+package approximation
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// weightedPoints() returns the points x, y, w of a smooth function with the noise on a non-uniform grid.
+func weightedPoints(seed int64, n int) [][]float64 {
+	r := rand.New(rand.NewSource(seed))
+	points := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		x := 1 + 3*r.Float64()
+		points[i] = []float64{x, math.Log(x)*math.Cos(2*x) + 0.05*r.NormFloat64(), 0.5 + r.Float64()}
+	}
+	return points
+}
+
+func TestOrthogonalMatchesPolynomial(t *testing.T) {
+	points := weightedPoints(1, 40)
+	for _, basis := range []Basis{Legendre, Chebyshev, Gram} {
+		for degree := 0; degree <= 8; degree++ {
+			fit, err := FitOrthogonal(points, degree, basis)
+			if err != nil {
+				t.Fatalf("%v, degree %d: %v", basis, degree, err)
+			}
+			polynomial, err := FitPolynomial(points, degree, QR)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The best approximation does not depend on the basis.
+			for x := 1.0; x <= 4; x += 0.05 {
+				if got, expected := fit.Calc(x), polynomial.Calc(x); math.Abs(got-expected) > 1e-8*(1+math.Abs(expected)) {
+					t.Fatalf("%v, degree %d: p(%g) = %g, the monomial basis %g", basis, degree, x, got, expected)
+				}
+			}
+			if got, expected := fit.Stats(), polynomial.Stats(); math.Abs(got.RMS-expected.RMS) > 1e-10 {
+				t.Fatalf("%v, degree %d: RMS %g, the monomial basis %g", basis, degree, got.RMS, expected.RMS)
+			}
+		}
+	}
+}
+
+func TestOrthogonalIncrease(t *testing.T) {
+	points := weightedPoints(2, 30)
+	for _, basis := range []Basis{Legendre, Chebyshev, Gram} {
+		fit, err := FitOrthogonal(points, 0, basis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for degree := 1; degree <= 10; degree++ {
+			if err = fit.Increase(); err != nil {
+				t.Fatalf("%v, degree %d: %v", basis, degree, err)
+			}
+			if fit.Degree() != degree {
+				t.Fatalf("%v: the degree %d after the increase, expected %d", basis, fit.Degree(), degree)
+			}
+			// FitOrthogonal() increases the degree too, the fresh fit solves the whole least squares problem by QR.
+			a := make([][]float64, len(fit.t))
+			for i := range a {
+				a[i] = fit.polynomials(fit.t[i], degree)
+			}
+			expected, err := solveWeighted(a, fit.y, fit.w, QR)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := fit.Coefficients()
+			for k := range expected {
+				if math.Abs(got[k]-expected[k]) > 1e-10*(1+math.Abs(expected[k])) {
+					t.Fatalf("%v, degree %d: the coefficients %v, the fresh fit %v", basis, degree, got, expected)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package approximation
+
+// PolynomialFit is the polynomial p(x) = c0 + c1 x + ... + cn x^n minimizing sum(wi (yi - p(xi))^2).
+type PolynomialFit struct {
+	coefs     []float64
+	residuals []float64
+	stats     Stats
+}
+
+// FitPolynomial() fits the polynomial of the given degree to the points by the weighted least squares.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+// points[i][2] - the weight of the point, wi >= 0 (optional, 1 by default).
+// At least degree + 1 points with positive weights are required.
+func FitPolynomial(points [][]float64, degree int, method Method) (*PolynomialFit, error) {
+	if degree < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	args, y, w, err := weightedData(points, 1, degree+1)
+	if err != nil {
+		return nil, err
+	}
+
+	a := make([][]float64, len(args))
+	for i := 0; i < len(args); i++ {
+		a[i] = make([]float64, degree+1)
+		a[i][0] = 1
+		for k := 1; k <= degree; k++ {
+			a[i][k] = a[i][k-1] * args[i][0]
+		}
+	}
+	coefs, err := solveWeighted(a, y, w, method)
+	if err != nil {
+		return nil, err
+	}
+
+	fit := &PolynomialFit{coefs: coefs}
+	values := make([]float64, len(args))
+	for i := 0; i < len(args); i++ {
+		values[i] = fit.Calc(args[i][0])
+	}
+	fit.residuals, fit.stats = statistics(y, w, values)
+	return fit, nil
+}
+
+// Calc() calculates p(x) by the Horner's scheme.
+func (p *PolynomialFit) Calc(x float64) float64 {
+	var result float64
+	for k := len(p.coefs) - 1; k >= 0; k-- {
+		result = result*x + p.coefs[k]
+	}
+	return result
+}
+
+// Degree() returns the degree of the polynomial.
+func (p *PolynomialFit) Degree() int {
+	return len(p.coefs) - 1
+}
+
+// Coefficients() returns a copy of the coefficients c0, c1, ..., cn.
+func (p *PolynomialFit) Coefficients() []float64 {
+	return append([]float64{}, p.coefs...)
+}
+
+// Residuals() returns a copy of the residuals yi - p(xi) in the order of the points.
+func (p *PolynomialFit) Residuals() []float64 {
+	return append([]float64{}, p.residuals...)
+}
+
+// Stats() returns the statistics of the approximation.
+func (p *PolynomialFit) Stats() Stats {
+	return p.stats
+}
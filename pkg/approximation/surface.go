@@ -0,0 +1,101 @@
+package approximation
+
+// SurfaceFit is the polynomial p(x, y) = sum(cij x^i y^j), i + j <= n, minimizing sum(wi (zi - p(xi, yi))^2).
+type SurfaceFit struct {
+	degree    int
+	terms     [][2]int
+	coefs     []float64
+	residuals []float64
+	stats     Stats
+}
+
+// FitSurface() fits the polynomial of the total degree n to the points by the weighted least squares.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+// points[i][2] - z coordinate.
+// points[i][3] - the weight of the point, wi >= 0 (optional, 1 by default).
+// At least (n + 1)(n + 2) / 2 points with positive weights are required.
+func FitSurface(points [][]float64, degree int, method Method) (*SurfaceFit, error) {
+	if degree < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	terms := surfaceTerms(degree)
+	args, z, w, err := weightedData(points, 2, len(terms))
+	if err != nil {
+		return nil, err
+	}
+
+	a := make([][]float64, len(args))
+	for i := 0; i < len(args); i++ {
+		a[i] = monomials(terms, args[i][0], args[i][1])
+	}
+	coefs, err := solveWeighted(a, z, w, method)
+	if err != nil {
+		return nil, err
+	}
+
+	fit := &SurfaceFit{degree: degree, terms: terms, coefs: coefs}
+	values := make([]float64, len(args))
+	for i := 0; i < len(args); i++ {
+		values[i] = dot(a[i], coefs)
+	}
+	fit.residuals, fit.stats = statistics(z, w, values)
+	return fit, nil
+}
+
+// surfaceTerms() returns the exponents (i, j) of the monomials x^i y^j of the total degree from 0 to n:
+// 1, x, y, x^2, x y, y^2, ...
+func surfaceTerms(degree int) [][2]int {
+	terms := make([][2]int, 0, (degree+1)*(degree+2)/2)
+	for d := 0; d <= degree; d++ {
+		for j := 0; j <= d; j++ {
+			terms = append(terms, [2]int{d - j, j})
+		}
+	}
+	return terms
+}
+
+// monomials() calculates x^i y^j for every term.
+func monomials(terms [][2]int, x, y float64) []float64 {
+	result := make([]float64, len(terms))
+	for k, term := range terms {
+		result[k] = 1
+		for i := 0; i < term[0]; i++ {
+			result[k] *= x
+		}
+		for j := 0; j < term[1]; j++ {
+			result[k] *= y
+		}
+	}
+	return result
+}
+
+// Calc() calculates p(x, y).
+func (s *SurfaceFit) Calc(x, y float64) float64 {
+	return dot(monomials(s.terms, x, y), s.coefs)
+}
+
+// Degree() returns the total degree of the polynomial.
+func (s *SurfaceFit) Degree() int {
+	return s.degree
+}
+
+// Terms() returns the exponents (i, j) of the monomials x^i y^j in the order of the coefficients.
+func (s *SurfaceFit) Terms() [][2]int {
+	return append([][2]int{}, s.terms...)
+}
+
+// Coefficients() returns a copy of the coefficients cij in the order of Terms().
+func (s *SurfaceFit) Coefficients() []float64 {
+	return append([]float64{}, s.coefs...)
+}
+
+// Residuals() returns a copy of the residuals zi - p(xi, yi) in the order of the points.
+func (s *SurfaceFit) Residuals() []float64 {
+	return append([]float64{}, s.residuals...)
+}
+
+// Stats() returns the statistics of the approximation.
+func (s *SurfaceFit) Stats() Stats {
+	return s.stats
+}
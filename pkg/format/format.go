@@ -0,0 +1,140 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+)
+
+func ReadPolynomialDegree() (int, error) {
+	var n int
+	fmt.Printf("Введите степень полинома (целое): ")
+	_, err := fmt.Scan(&n)
+	return n, err
+}
+
+func ReadValue() (float64, error) {
+	var x float64
+	fmt.Print("Введите значение x (вещественное): ")
+	_, err := fmt.Scan(&x)
+	return x, err
+}
+
+// ReadPoint() reads the coordinates of a point, names - the names of the coordinates.
+func ReadPoint(names ...string) ([]float64, error) {
+	point := make([]float64, len(names))
+	fmt.Printf("Введите значения %s (вещественные): ", strings.Join(names, ", "))
+	for i := 0; i < len(point); i++ {
+		if _, err := fmt.Scan(&point[i]); err != nil {
+			return nil, err
+		}
+	}
+	return point, nil
+}
+
+// ReadDegrees() reads the degrees of the polynomials, names - the names of the degrees.
+func ReadDegrees(names ...string) ([]int, error) {
+	degrees := make([]int, len(names))
+	fmt.Printf("Введите степени %s (целые): ", strings.Join(names, ", "))
+	for i := 0; i < len(degrees); i++ {
+		if _, err := fmt.Scan(&degrees[i]); err != nil {
+			return nil, err
+		}
+	}
+	return degrees, nil
+}
+
+func ReadFileName() (string, error) {
+	var name string
+	fmt.Print("Введите название файла: ")
+	_, err := fmt.Scan(&name)
+	return name, err
+}
+
+func ReadNumOfDerivates() (int, error) {
+	var n int
+	fmt.Print("Введите количество используемых производных: ")
+	_, err := fmt.Scan(&n)
+	return n, err
+}
+
+func ReadBoundaryKind(end string) (int, error) {
+	var n int
+	fmt.Printf("Краевое условие на %s конце (0 - естественное, 1 - S'', 2 - S', 3 - not-a-knot, 4 - периодическое): ", end)
+	_, err := fmt.Scan(&n)
+	return n, err
+}
+
+// ReadModel() reads the number of the model, names - the names of the models.
+func ReadModel(names ...string) (int, error) {
+	options := make([]string, len(names))
+	for i := 0; i < len(names); i++ {
+		options[i] = fmt.Sprintf("%d - %s", i, names[i])
+	}
+	var n int
+	fmt.Printf("Выберите модель (%s): ", strings.Join(options, ", "))
+	_, err := fmt.Scan(&n)
+	return n, err
+}
+
+func ReadDerivative() (float64, error) {
+	var d float64
+	fmt.Print("Введите значение производной (вещественное): ")
+	_, err := fmt.Scan(&d)
+	return d, err
+}
+
+func PrintHermitResult(res float64) {
+	fmt.Println("\nРезультат, полученный с помощью полинома Эрмита H(x):", res)
+}
+
+func PrintNewtonResult(res float64) {
+	fmt.Println("\nРезультат, полученный с помощью полинома Ньютона P(x):", res)
+}
+
+func PrintSplineResult(res float64) {
+	fmt.Println("\nРезультат, полученный с помощью сплайна:", res)
+}
+
+func PrintResult(name string, res float64) {
+	fmt.Printf("\nРезультат, полученный с помощью метода %s: %v\n", name, res)
+}
+
+func PrintSystemResult(x, y float64) {
+	fmt.Printf("\nРешение системы уравнений: x = %f, y = %f\n", x, y)
+}
+
+func PrintLine(k int) {
+	for i := 0; i < k; i++ {
+		fmt.Print("-")
+	}
+	fmt.Println()
+}
+
+// PrintTable() prints a table of values with named rows and columns.
+// values[i][j] - the value in the row i and the column j.
+func PrintTable(corner string, columns []string, rows []string, values [][]float64) {
+	width := len([]rune(corner))
+	for i := 0; i < len(rows); i++ {
+		width = max(width, len([]rune(rows[i])))
+	}
+	width = max(width, 10)
+	k := width + 3 + 17*len(columns) + 1
+
+	PrintLine(k)
+	fmt.Printf("| %-*s ", width, corner)
+	for j := 0; j < len(columns); j++ {
+		fmt.Printf("| %-14s ", columns[j])
+	}
+	fmt.Println("|")
+
+	for i := 0; i < len(rows); i++ {
+		fmt.Printf("|%s|\n", strings.Repeat("-", k-2))
+		fmt.Printf("| %-*s ", width, rows[i])
+		for j := 0; j < len(values[i]); j++ {
+			fmt.Printf("| %-14f ", values[i][j])
+		}
+		fmt.Println("|")
+	}
+	PrintLine(k)
+	fmt.Println()
+}
@@ -0,0 +1,3 @@
+module github.com/hahaclassic/computational-algorithms.git/pkg
+
+go 1.21.6
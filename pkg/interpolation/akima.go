@@ -0,0 +1,90 @@
+package interpolation
+
+import "math"
+
+// Akima is the Akima spline: a piecewise cubic Hermite polynomial with the derivatives
+// calculated only from the slopes of the neighbouring segments, so it does not overshoot
+// near abrupt changes of the slope.
+type Akima struct {
+	piecewise
+	modified bool
+}
+
+// CreateAkima() creates the Akima spline.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateAkima(points [][]float64) (*Akima, error) {
+	return createAkima(points, false)
+}
+
+// CreateModifiedAkima() creates the modified Akima spline (makima), which additionally
+// avoids overshoot when neighbouring slopes are equal or change their sign.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateModifiedAkima(points [][]float64) (*Akima, error) {
+	return createAkima(points, true)
+}
+
+func createAkima(points [][]float64, modified bool) (*Akima, error) {
+	if len(points) < 2 {
+		return nil, ErrNotEnoughInputData
+	}
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	derivatives := akimaDerivatives(segmentSlopes(sorted), modified)
+
+	return &Akima{
+		piecewise: piecewise{
+			points: sorted,
+			config: hermiteSegments(sorted, derivatives),
+		},
+		modified: modified,
+	}, nil
+}
+
+// segmentSlopes() returns the slopes mi = (yi+1 - yi) / (xi+1 - xi) of the segments.
+func segmentSlopes(points [][]float64) []float64 {
+	slopes := make([]float64, len(points)-1)
+	for i := 0; i < len(slopes); i++ {
+		slopes[i] = (points[i+1][1] - points[i][1]) / (points[i+1][0] - points[i][0])
+	}
+	return slopes
+}
+
+// akimaDerivatives() calculates ti = (w1 * mi-1 + w2 * mi) / (w1 + w2),
+// w1 = |mi+1 - mi|, w2 = |mi-1 - mi-2| (+ |mi+1 + mi| / 2 and |mi-1 + mi-2| / 2 for makima).
+// Two slopes are added at each end by the linear extrapolation.
+func akimaDerivatives(slopes []float64, modified bool) []float64 {
+	n := len(slopes)
+	// m[k + 2] == slopes[k]
+	m := make([]float64, n+4)
+	copy(m[2:], slopes)
+	if n == 1 {
+		m[0], m[1], m[3], m[4] = slopes[0], slopes[0], slopes[0], slopes[0]
+	} else {
+		m[1] = 2*m[2] - m[3]
+		m[0] = 2*m[1] - m[2]
+		m[n+2] = 2*m[n+1] - m[n]
+		m[n+3] = 2*m[n+2] - m[n+1]
+	}
+
+	derivatives := make([]float64, n+1)
+	for i := 0; i <= n; i++ {
+		// mi-2, mi-1, mi, mi+1 == m[i], m[i+1], m[i+2], m[i+3]
+		w1 := math.Abs(m[i+3] - m[i+2])
+		w2 := math.Abs(m[i+1] - m[i])
+		if modified {
+			w1 += math.Abs(m[i+3]+m[i+2]) / 2
+			w2 += math.Abs(m[i+1]+m[i]) / 2
+		}
+		if w1+w2 == 0 {
+			derivatives[i] = (m[i+1] + m[i+2]) / 2
+		} else {
+			derivatives[i] = (w1*m[i+1] + w2*m[i+2]) / (w1 + w2)
+		}
+	}
+	return derivatives
+}
@@ -0,0 +1,112 @@
+package interpolation
+
+// Barycentric implements the barycentric (second form) interpolation.
+// Weights of the whole table are calculated once, so the evaluation with n == len(points) - 1 takes O(n).
+type Barycentric struct {
+	points  [][]float64
+	weights []float64
+}
+
+// CreateBarycentric() creates a Barycentric structure that implements the barycentric interpolation.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateBarycentric(points [][]float64) (*Barycentric, error) {
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+	return &Barycentric{
+		points:  sorted,
+		weights: barycentricWeights(sorted),
+	}, nil
+}
+
+// Calc() calculates the approximate value of y(x) for the degree of the polynomial n.
+// n + 1 points, as close as possible to x, are used (the same as for the Newton polynomial).
+// x - the input value.
+// n - the degree of the polynomial.
+func (b *Barycentric) Calc(x float64, n int) (float64, error) {
+	if n < 0 {
+		return UndefNum, ErrInvalidPolynomialDegree
+	}
+	if len(b.points) <= n {
+		return UndefNum, ErrNotEnoughInputData
+	}
+
+	nodes, weights := b.window(x, n)
+
+	return barycentricResult(nodes, weights, x), nil
+}
+
+// EvaluateMany() calculates the approximate values of y(x) for every x with the degree of the polynomial n.
+// The weights are calculated once per group of queries using the same nodes.
+// workers - the maximum number of goroutines processing the groups (workers <= 1 - the calling goroutine only).
+func (b *Barycentric) EvaluateMany(xs []float64, n int, workers int) ([]float64, error) {
+	if n < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	if len(b.points) <= n {
+		return nil, ErrNotEnoughInputData
+	}
+
+	groups := groupByWindow(xs, func(x float64) window {
+		start, end := nearestNodes(b.points, x, n)
+		return window{start: start, end: end}
+	})
+
+	result := make([]float64, len(xs))
+	runPool(len(groups), workers, func(i int) {
+		nodes, weights := b.window(xs[groups[i].indices[0]], n)
+		for _, idx := range groups[i].indices {
+			result[idx] = barycentricResult(nodes, weights, xs[idx])
+		}
+	})
+
+	return result, nil
+}
+
+// window() returns n + 1 points, as close as possible to x, and their weights.
+func (b *Barycentric) window(x float64, n int) ([][]float64, []float64) {
+	if n == len(b.points)-1 {
+		return b.points, b.weights
+	}
+	start, end := nearestNodes(b.points, x, n)
+	nodes := b.points[start:end]
+
+	return nodes, barycentricWeights(nodes)
+}
+
+// barycentricWeights() calculates wi = 1 / П(xi - xj), j != i.
+// The differences are scaled by 4 / (xn - x0) to avoid overflow, the common factor is cancelled in the formula.
+func barycentricWeights(nodes [][]float64) []float64 {
+	scale := 1.0
+	if len(nodes) > 1 && nodes[len(nodes)-1][0] != nodes[0][0] {
+		scale = 4 / (nodes[len(nodes)-1][0] - nodes[0][0])
+	}
+
+	weights := make([]float64, len(nodes))
+	for i := 0; i < len(nodes); i++ {
+		product := 1.0
+		for j := 0; j < len(nodes); j++ {
+			if i != j {
+				product *= (nodes[i][0] - nodes[j][0]) * scale
+			}
+		}
+		weights[i] = 1 / product
+	}
+	return weights
+}
+
+// barycentricResult() calculates y(x) = Σ(wi * yi / (x - xi)) / Σ(wi / (x - xi)).
+func barycentricResult(nodes [][]float64, weights []float64, x float64) float64 {
+	var numerator, denominator float64
+	for i := 0; i < len(nodes); i++ {
+		if x == nodes[i][0] {
+			return nodes[i][1]
+		}
+		t := weights[i] / (x - nodes[i][0])
+		numerator += t * nodes[i][1]
+		denominator += t
+	}
+	return numerator / denominator
+}
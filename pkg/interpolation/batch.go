@@ -0,0 +1,117 @@
+package interpolation
+
+import "sync"
+
+// window is a range of the sorted points used to build one table of divided differences.
+// points[start:end] are used, the point last is taken count times (Hermit only).
+type window struct {
+	start, end  int
+	last, count int
+}
+
+// group is a set of queries that share the same window.
+type group struct {
+	window  window
+	indices []int
+}
+
+// EvaluateMany() calculates the approximate values of y(x) for every x with the degree of the polynomial n.
+// The queries are grouped by the nodes they use, so the table of divided differences is built once per group.
+// workers - the maximum number of goroutines processing the groups (workers <= 1 - the calling goroutine only).
+func (newton *Newton) EvaluateMany(xs []float64, n int, workers int) ([]float64, error) {
+	if n < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	if len(newton.points) <= n {
+		return nil, ErrNotEnoughInputData
+	}
+
+	groups := groupByWindow(xs, func(x float64) window {
+		start, end := nearestNodes(newton.points, x, n)
+		return window{start: start, end: end}
+	})
+
+	result := make([]float64, len(xs))
+	runPool(len(groups), workers, func(i int) {
+		table := buildNewtonDiff(newton.points[groups[i].window.start:groups[i].window.end])
+		for _, idx := range groups[i].indices {
+			result[idx] = newtonResult(table, xs[idx])
+		}
+	})
+
+	return result, nil
+}
+
+// EvaluateMany() calculates the approximate values of y(x) for every x with the degree of the polynomial n.
+// The queries are grouped by the nodes they use, so the table of divided differences is built once per group.
+// workers - the maximum number of goroutines processing the groups (workers <= 1 - the calling goroutine only).
+func (h *Hermit) EvaluateMany(xs []float64, n int, workers int) ([]float64, error) {
+	if n < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	if len(h.points) <= n {
+		return nil, ErrNotEnoughInputData
+	}
+
+	groups := groupByWindow(xs, func(x float64) window {
+		return h.window(x, n)
+	})
+
+	result := make([]float64, len(xs))
+	runPool(len(groups), workers, func(i int) {
+		table := h.buildDiff(h.fillConfig(groups[i].window))
+		for _, idx := range groups[i].indices {
+			result[idx] = newtonResult(table, xs[idx])
+		}
+	})
+
+	return result, nil
+}
+
+// groupByWindow() groups the indices of xs by the window used for them.
+// Groups are returned in the order of the first query.
+func groupByWindow(xs []float64, windowOf func(x float64) window) []group {
+	groups := []group{}
+	numbers := map[window]int{}
+
+	for i := 0; i < len(xs); i++ {
+		w := windowOf(xs[i])
+		num, ok := numbers[w]
+		if !ok {
+			num = len(groups)
+			numbers[w] = num
+			groups = append(groups, group{window: w})
+		}
+		groups[num].indices = append(groups[num].indices, i)
+	}
+
+	return groups
+}
+
+// runPool() calls job for every number from 0 to numJobs - 1 using at most workers goroutines.
+func runPool(numJobs, workers int, job func(i int)) {
+	if workers <= 1 || numJobs <= 1 {
+		for i := 0; i < numJobs; i++ {
+			job(i)
+		}
+		return
+	}
+	workers = min(workers, numJobs)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				job(i)
+			}
+		}()
+	}
+	for i := 0; i < numJobs; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
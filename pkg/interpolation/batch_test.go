@@ -0,0 +1,104 @@
+package interpolation
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// queries() returns the unsorted x with the repeats, partly outside [0, 10].
+func queries(seed int64, n int) []float64 {
+	r := rand.New(rand.NewSource(seed))
+	xs := make([]float64, n)
+	for i := 0; i < n; i++ {
+		xs[i] = r.Float64()*12 - 1
+		if i > 0 && r.Intn(5) == 0 {
+			xs[i] = xs[r.Intn(i)]
+		}
+	}
+	return xs
+}
+
+func TestEvaluateManyMatchesCalc(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	points := make([][]float64, 15)
+	for i := range points {
+		x := float64(i)*10/14 + 0.2*r.Float64()
+		points[i] = []float64{x, math.Sin(x), math.Cos(x), -math.Sin(x)}
+	}
+	xs := queries(2, 500)
+
+	newton, err := CreateNewtonPolinomial(points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	barycentric, err := CreateBarycentric(points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type method struct {
+		name string
+		calc func(x float64, n int) (float64, error)
+		many func(xs []float64, n, workers int) ([]float64, error)
+	}
+	methods := []method{
+		{"Newton", newton.Calc, newton.EvaluateMany},
+		{"Barycentric", barycentric.Calc, barycentric.EvaluateMany},
+	}
+	for derivatives := 0; derivatives <= 2; derivatives++ {
+		hermit, err := CreateHermitPolinomial(points, derivatives)
+		if err != nil {
+			t.Fatal(err)
+		}
+		methods = append(methods, method{fmt.Sprintf("Hermit (%d derivatives)", derivatives), hermit.Calc, hermit.EvaluateMany})
+	}
+
+	for _, m := range methods {
+		for n := 0; n <= 6; n++ {
+			for _, workers := range []int{1, 2, 4, 16} {
+				result, err := m.many(xs, n, workers)
+				if err != nil {
+					t.Fatalf("%s, n = %d: %v", m.name, n, err)
+				}
+				for i, x := range xs {
+					expected, err := m.calc(x, n)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if math.Abs(result[i]-expected) > 1e-12*(1+math.Abs(expected)) {
+						t.Fatalf("%s, n = %d, %d workers: y(%g) = %g, Calc() %g", m.name, n, workers, x, result[i], expected)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestPiecewiseEvaluateManyMatchesCalc(t *testing.T) {
+	points := make([][]float64, 20)
+	for i := range points {
+		x := float64(i) / 2
+		points[i] = []float64{x, math.Exp(-x) * math.Cos(x)}
+	}
+	spline, err := CreateSpline(points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs := queries(3, 500)
+	// The sorted runs are evaluated in one pass over the segments.
+	sorted := append([]float64{}, xs...)
+	slices.Sort(sorted)
+
+	for _, input := range [][]float64{xs, sorted} {
+		for _, workers := range []int{1, 2, 4, 16} {
+			result := spline.EvaluateMany(input, workers)
+			for i, x := range input {
+				if expected := spline.Calc(x); result[i] != expected {
+					t.Fatalf("%d workers: S(%g) = %g, Calc() %g", workers, x, result[i], expected)
+				}
+			}
+		}
+	}
+}
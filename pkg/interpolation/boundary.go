@@ -0,0 +1,53 @@
+package interpolation
+
+type BoundaryKind int
+
+const (
+	Natural          BoundaryKind = iota // S'' == 0
+	SecondDerivative                     // S'' == Value
+	Clamped                              // S' == Value
+	NotAKnot                             // S''' is continuous at the second (the last but one) point
+	Periodic                             // S, S' and S'' are equal at both ends, must be set on both ends
+)
+
+// BoundaryCondition is the condition for the spline at one end of the table.
+type BoundaryCondition struct {
+	Kind  BoundaryKind
+	Value float64
+}
+
+func NaturalCond() BoundaryCondition {
+	return BoundaryCondition{Kind: Natural}
+}
+
+// SecondDerivativeCond() - the given second derivative at the end.
+func SecondDerivativeCond(d2 float64) BoundaryCondition {
+	return BoundaryCondition{Kind: SecondDerivative, Value: d2}
+}
+
+// ClampedCond() - the given first derivative at the end.
+func ClampedCond(d1 float64) BoundaryCondition {
+	return BoundaryCondition{Kind: Clamped, Value: d1}
+}
+
+func NotAKnotCond() BoundaryCondition {
+	return BoundaryCondition{Kind: NotAKnot}
+}
+
+func PeriodicCond() BoundaryCondition {
+	return BoundaryCondition{Kind: Periodic}
+}
+
+func (k BoundaryKind) String() string {
+	names := []string{
+		"natural",
+		"second derivative",
+		"clamped",
+		"not-a-knot",
+		"periodic",
+	}
+	if k < 0 || int(k) >= len(names) {
+		return "unknown"
+	}
+	return names[k]
+}
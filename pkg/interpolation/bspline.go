@@ -0,0 +1,281 @@
+package interpolation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/linalg"
+)
+
+// Knots is a non-decreasing knot vector of a B-spline.
+type Knots []float64
+
+// ClampedKnots() returns the knot vector of degree p with the given breakpoints,
+// the first and the last breakpoints are repeated p + 1 times.
+func ClampedKnots(breakpoints []float64, p int) Knots {
+	knots := make(Knots, 0, len(breakpoints)+2*p)
+	for i := 0; i < p; i++ {
+		knots = append(knots, breakpoints[0])
+	}
+	knots = append(knots, breakpoints...)
+	for i := 0; i < p; i++ {
+		knots = append(knots, breakpoints[len(breakpoints)-1])
+	}
+	return knots
+}
+
+// AveragedKnots() returns the knot vector of degree p for the interpolation at the points xs (de Boor averaging):
+// the ends are repeated p + 1 times, the inner knots are the means of p consecutive inner points,
+// so the Schoenberg-Whitney conditions are satisfied and the interpolation system is not singular.
+func AveragedKnots(xs []float64, p int) Knots {
+	n := len(xs)
+	knots := make(Knots, n+p+1)
+	for i := 0; i <= p; i++ {
+		knots[i] = xs[0]
+		knots[n+i] = xs[n-1]
+	}
+	for j := 1; j < n-p; j++ {
+		var sum float64
+		for i := j; i < j+p; i++ {
+			sum += xs[i]
+		}
+		knots[j+p] = sum / float64(p)
+	}
+	return knots
+}
+
+// valid() checks that the knots are non-decreasing and the vector has enough knots for n basis functions of degree p.
+func (k Knots) valid(n, p int) bool {
+	if p < 0 || n < p+1 || len(k) != n+p+1 || k[p] >= k[n] {
+		return false
+	}
+	for i := 1; i < len(k); i++ {
+		if k[i] < k[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// Span() returns the index i, p <= i < n, of the knot span [ti, ti+1) containing x
+// for n basis functions of degree p (the outer spans are used outside [tp, tn]).
+func (k Knots) Span(x float64, n, p int) int {
+	i := sort.SearchFloat64s(k, math.Nextafter(x, math.Inf(1))) - 1
+	return max(p, min(i, n-1))
+}
+
+// Basis() calculates the B-spline basis function Ni,p(x) by the Cox-de Boor recursion:
+// Ni,0(x) = 1 if ti <= x < ti+1, else 0 (the last non-empty span is closed),
+// Ni,p(x) = (x - ti) / (ti+p - ti) Ni,p-1(x) + (ti+p+1 - x) / (ti+p+1 - ti+1) Ni+1,p-1(x).
+func (k Knots) Basis(i, p int, x float64) float64 {
+	if p == 0 {
+		last := k[len(k)-1]
+		if k[i] <= x && x < k[i+1] || x == last && k[i] < x && k[i+1] == last {
+			return 1
+		}
+		return 0
+	}
+
+	var result float64
+	if h := k[i+p] - k[i]; h != 0 {
+		result += (x - k[i]) / h * k.Basis(i, p-1, x)
+	}
+	if h := k[i+p+1] - k[i+1]; h != 0 {
+		result += (k[i+p+1] - x) / h * k.Basis(i+1, p-1, x)
+	}
+	return result
+}
+
+// Breakpoints() returns the distinct knots.
+func (k Knots) Breakpoints() []float64 {
+	result := []float64{}
+	for i := 0; i < len(k); i++ {
+		if i == 0 || k[i] != k[i-1] {
+			result = append(result, k[i])
+		}
+	}
+	return result
+}
+
+// BSpline is the spline of degree p: S(x) = sum(ci * Ni,p(x)).
+type BSpline struct {
+	degree int
+	knots  Knots
+	coefs  []float64
+	points [][]float64 // the interpolated points, nil if the spline is created by the coefficients
+}
+
+// CreateBSpline() creates the B-spline of degree p interpolating the points, the knots are chosen by AveragedKnots().
+// p = 1 - the broken line, p = 2 - the quadratic spline, p = 5 - the quintic spline.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateBSpline(points [][]float64, p int) (*BSpline, error) {
+	if p < 1 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	if len(points) < p+1 {
+		return nil, ErrNotEnoughInputData
+	}
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(sorted)
+	xs := make([]float64, n)
+	ys := make([]float64, n)
+	for i := 0; i < n; i++ {
+		if i > 0 && sorted[i][0] == sorted[i-1][0] {
+			return nil, ErrSingularSystem
+		}
+		xs[i], ys[i] = sorted[i][0], sorted[i][1]
+	}
+	knots := AveragedKnots(xs, p)
+
+	// The collocation matrix: a[i][j] = Nj,p(xi).
+	a := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		a[i] = make([]float64, n)
+		span := knots.Span(xs[i], n, p)
+		for j := span - p; j <= span; j++ {
+			a[i][j] = knots.Basis(j, p, xs[i])
+		}
+	}
+
+	coefs, err := linalg.Solve(a, ys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BSpline{degree: p, knots: knots, coefs: coefs, points: sorted}, nil
+}
+
+// CreateBSplineByCoefs() creates the B-spline of degree p by the knots and the coefficients,
+// len(knots) must be equal to len(coefs) + p + 1.
+func CreateBSplineByCoefs(knots Knots, coefs []float64, p int) (*BSpline, error) {
+	if !knots.valid(len(coefs), p) {
+		return nil, ErrInvalidKnots
+	}
+	return &BSpline{
+		degree: p,
+		knots:  append(Knots{}, knots...),
+		coefs:  append([]float64{}, coefs...),
+	}, nil
+}
+
+// Degree() returns the degree of the spline.
+func (s *BSpline) Degree() int {
+	return s.degree
+}
+
+// Knots() returns a copy of the knot vector.
+func (s *BSpline) Knots() Knots {
+	return append(Knots{}, s.knots...)
+}
+
+// Coefficients() returns a copy of the coefficients of the basis functions.
+func (s *BSpline) Coefficients() []float64 {
+	return append([]float64{}, s.coefs...)
+}
+
+// Calc() calculates S(x) by the de Boor algorithm (the outer polynomials are used outside the knots).
+func (s *BSpline) Calc(x float64) float64 {
+	p, t := s.degree, s.knots
+	k := t.Span(x, len(s.coefs), p)
+
+	d := make([]float64, p+1)
+	copy(d, s.coefs[k-p:k+1])
+	for r := 1; r <= p; r++ {
+		for j := p; j >= r; j-- {
+			left, right := t[j+k-p], t[j+1+k-r]
+			alpha := (x - left) / (right - left)
+			d[j] = (1-alpha)*d[j-1] + alpha*d[j]
+		}
+	}
+	return d[p]
+}
+
+// Derivative() returns the derivative of the spline, the B-spline of degree p - 1:
+// qi = p (ci+1 - ci) / (ti+p+1 - ti+1).
+func (s *BSpline) Derivative() *BSpline {
+	p, t := s.degree, s.knots
+	if p == 0 {
+		return &BSpline{knots: s.Knots(), coefs: make([]float64, len(s.coefs))}
+	}
+
+	coefs := make([]float64, len(s.coefs)-1)
+	for i := 0; i < len(coefs); i++ {
+		if h := t[i+p+1] - t[i+1]; h != 0 {
+			coefs[i] = float64(p) * (s.coefs[i+1] - s.coefs[i]) / h
+		}
+	}
+	return &BSpline{degree: p - 1, knots: append(Knots{}, t[1:len(t)-1]...), coefs: coefs}
+}
+
+// Antiderivative() returns the antiderivative of the spline equal to 0 at the first knot, the B-spline of degree p + 1:
+// q0 = 0, qi+1 = qi + ci (ti+p+1 - ti) / (p + 1).
+func (s *BSpline) Antiderivative() *BSpline {
+	p, t := s.degree, s.knots
+
+	knots := make(Knots, 0, len(t)+2)
+	knots = append(knots, t[0])
+	knots = append(knots, t...)
+	knots = append(knots, t[len(t)-1])
+
+	coefs := make([]float64, len(s.coefs)+1)
+	for i := 0; i < len(s.coefs); i++ {
+		coefs[i+1] = coefs[i] + s.coefs[i]*(t[i+p+1]-t[i])/float64(p+1)
+	}
+	return &BSpline{degree: p + 1, knots: knots, coefs: coefs}
+}
+
+// Integrate() calculates the integral of the spline from a to b.
+func (s *BSpline) Integrate(a, b float64) float64 {
+	antiderivative := s.Antiderivative()
+	return antiderivative.Calc(b) - antiderivative.Calc(a)
+}
+
+// ToPiecewise() converts the spline to the piecewise polynomial form used by Spline:
+// the coefficients on every segment between the breakpoints are S(k)(xi-1) / k!.
+func (s *BSpline) ToPiecewise() *PiecewisePolynomial {
+	points := s.breakpointValues()
+
+	config := make([][]float64, len(points))
+	for i := 1; i < len(config); i++ {
+		config[i] = make([]float64, s.degree+1)
+	}
+	derivative := s
+	var factorial float64 = 1
+	for k := 0; k <= s.degree; k++ {
+		for i := 1; i < len(config); i++ {
+			config[i][k] = derivative.Calc(points[i-1][0]) / factorial
+		}
+		derivative = derivative.Derivative()
+		factorial *= float64(k + 1)
+	}
+
+	return &PiecewisePolynomial{piecewise{points: points, config: config}}
+}
+
+// nodes() returns the interpolated points or the breakpoints with the values of the spline.
+func (s *BSpline) nodes() [][]float64 {
+	if s.points != nil {
+		return copyPoints(s.points)
+	}
+	return s.breakpointValues()
+}
+
+// breakpointValues() returns the breakpoints of the spline on [tp, tn] with the values of the spline.
+func (s *BSpline) breakpointValues() [][]float64 {
+	breakpoints := s.knots[s.degree : len(s.coefs)+1].Breakpoints()
+	points := make([][]float64, len(breakpoints))
+	for i := 0; i < len(points); i++ {
+		points[i] = []float64{breakpoints[i], s.Calc(breakpoints[i])}
+	}
+	return points
+}
+
+// PiecewisePolynomial is a piecewise polynomial function of any degree.
+type PiecewisePolynomial struct {
+	piecewise
+}
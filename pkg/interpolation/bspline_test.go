@@ -0,0 +1,84 @@
+package interpolation
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// polynomial() returns the polynomial of degree p with its derivative and antiderivative.
+func polynomial(p int) (f, d1, integral func(x float64) float64) {
+	coefs := []float64{0.5, -1, 2, 0.3, -0.7, 0.2}[:p+1]
+	f = func(x float64) float64 {
+		var result float64
+		for k := p; k >= 0; k-- {
+			result = result*x + coefs[k]
+		}
+		return result
+	}
+	d1 = func(x float64) float64 {
+		var result float64
+		for k := p; k >= 1; k-- {
+			result = result*x + float64(k)*coefs[k]
+		}
+		return result
+	}
+	integral = func(x float64) float64 {
+		var result float64
+		for k := p; k >= 0; k-- {
+			result = result*x + coefs[k]/float64(k+1)
+		}
+		return result * x
+	}
+	return f, d1, integral
+}
+
+func TestBSplineReproducesPolynomial(t *testing.T) {
+	xs := []float64{-1, -0.7, -0.2, 0.1, 0.3, 0.8, 1.1, 1.6, 1.8, 2}
+	for p := 1; p <= 5; p++ {
+		f, d1, integral := polynomial(p)
+		points := make([][]float64, len(xs))
+		for i, x := range xs {
+			points[i] = []float64{x, f(x)}
+		}
+		spline, err := CreateBSpline(points, p)
+		if err != nil {
+			t.Fatalf("p = %d: %v", p, err)
+		}
+		derivative := spline.Derivative()
+
+		for x := -1.0; x <= 2; x += 0.01 {
+			if got := spline.Calc(x); math.Abs(got-f(x)) > 1e-10 {
+				t.Fatalf("p = %d: S(%g) = %g, expected %g", p, x, got, f(x))
+			}
+			if got := derivative.Calc(x); math.Abs(got-d1(x)) > 1e-9 {
+				t.Fatalf("p = %d: S'(%g) = %g, expected %g", p, x, got, d1(x))
+			}
+			if got, expected := spline.Integrate(-0.5, x), integral(x)-integral(-0.5); math.Abs(got-expected) > 1e-10 {
+				t.Fatalf("p = %d: the integral from -0.5 to %g is %g, expected %g", p, x, got, expected)
+			}
+		}
+	}
+}
+
+func TestBSplineToPiecewise(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	breakpoints := []float64{0, 0.3, 0.4, 1, 1.7, 2.5, 3}
+	for p := 1; p <= 5; p++ {
+		knots := ClampedKnots(breakpoints, p)
+		coefs := make([]float64, len(knots)-p-1)
+		for i := range coefs {
+			coefs[i] = r.Float64()*2 - 1
+		}
+		spline, err := CreateBSplineByCoefs(knots, coefs, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		form := spline.ToPiecewise()
+		for x := 0.0; x <= 3; x += 0.01 {
+			if got, expected := form.Calc(x), spline.Calc(x); math.Abs(got-expected) > 1e-10 {
+				t.Fatalf("p = %d: the piecewise form %g at %g, the B-spline %g", p, got, x, expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package interpolation
+
+import "math"
+
+// Chebyshev implements the interpolation by the Chebyshev series
+// P(x) = Σ cj * Tj(t), t = (2x - a - b) / (b - a), with the nodes of the first kind on [a, b].
+type Chebyshev struct {
+	a, b   float64
+	coeffs []float64
+	points [][]float64
+}
+
+// ChebyshevNodes() returns n Chebyshev nodes of the first kind (roots of Tn) on [a, b] in ascending order.
+func ChebyshevNodes(a, b float64, n int) ([]float64, error) {
+	if a >= b {
+		return nil, ErrInvalidInterval
+	}
+	if n < 1 {
+		return nil, ErrNotEnoughInputData
+	}
+	nodes := make([]float64, n)
+	for i := 0; i < n; i++ {
+		nodes[i] = (a+b)/2 - (b-a)/2*math.Cos(float64(2*i+1)*math.Pi/float64(2*n))
+	}
+	return nodes, nil
+}
+
+// ChebyshevLobattoNodes() returns n Chebyshev nodes of the second kind (extrema of Tn-1, ends included) on [a, b] in ascending order.
+func ChebyshevLobattoNodes(a, b float64, n int) ([]float64, error) {
+	if a >= b {
+		return nil, ErrInvalidInterval
+	}
+	if n < 2 {
+		return nil, ErrNotEnoughInputData
+	}
+	nodes := make([]float64, n)
+	for i := 0; i < n; i++ {
+		nodes[i] = (a+b)/2 - (b-a)/2*math.Cos(float64(i)*math.Pi/float64(n-1))
+	}
+	nodes[0], nodes[n-1] = a, b
+	return nodes, nil
+}
+
+// Tabulate() returns the table of the function f at the nodes.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func Tabulate(f func(x float64) float64, nodes []float64) [][]float64 {
+	points := make([][]float64, len(nodes))
+	for i := 0; i < len(nodes); i++ {
+		points[i] = []float64{nodes[i], f(nodes[i])}
+	}
+	return points
+}
+
+// CreateChebyshev() creates the Chebyshev interpolation of degree n of the function f on [a, b].
+func CreateChebyshev(f func(x float64) float64, a, b float64, n int) (*Chebyshev, error) {
+	if n < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	nodes, err := ChebyshevNodes(a, b, n+1)
+	if err != nil {
+		return nil, err
+	}
+	points := Tabulate(f, nodes)
+
+	values := make([]float64, len(points))
+	for i := 0; i < len(points); i++ {
+		values[i] = points[i][1]
+	}
+	return CreateChebyshevByValues(a, b, values)
+}
+
+// CreateChebyshevByValues() creates the Chebyshev interpolation on [a, b] of degree len(values) - 1.
+// values[i] - the value of the function at the i-th node returned by ChebyshevNodes(a, b, len(values)).
+func CreateChebyshevByValues(a, b float64, values []float64) (*Chebyshev, error) {
+	nodes, err := ChebyshevNodes(a, b, len(values))
+	if err != nil {
+		return nil, err
+	}
+	num := len(values)
+
+	c := &Chebyshev{
+		a:      a,
+		b:      b,
+		coeffs: make([]float64, num),
+		points: make([][]float64, num),
+	}
+	for i := 0; i < num; i++ {
+		c.points[i] = []float64{nodes[i], values[i]}
+	}
+
+	// cj = 2/N * Σ yi * Tj(ti), the nodes are ascending, so ti = cos(π - θi), θi = (2i + 1)π / 2N.
+	for j := 0; j < num; j++ {
+		var sum float64
+		for i := 0; i < num; i++ {
+			theta := math.Pi - float64(2*i+1)*math.Pi/float64(2*num)
+			sum += values[i] * math.Cos(float64(j)*theta)
+		}
+		c.coeffs[j] = 2 * sum / float64(num)
+	}
+	c.coeffs[0] /= 2
+
+	return c, nil
+}
+
+// Coefficients() returns a copy of the coefficients cj of the series.
+func (c *Chebyshev) Coefficients() []float64 {
+	coeffs := make([]float64, len(c.coeffs))
+	copy(coeffs, c.coeffs)
+	return coeffs
+}
+
+// Calc() calculates the approximate value of y(x) by the Clenshaw algorithm.
+func (c *Chebyshev) Calc(x float64) float64 {
+	t := (2*x - c.a - c.b) / (c.b - c.a)
+
+	var b1, b2 float64
+	for j := len(c.coeffs) - 1; j >= 1; j-- {
+		b1, b2 = c.coeffs[j]+2*t*b1-b2, b1
+	}
+	return c.coeffs[0] + t*b1 - b2
+}
@@ -0,0 +1,98 @@
+package interpolation
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/linalg"
+)
+
+var (
+	ErrNotEnoughInputData      = errors.New("not enough input data") // decrease the degree of the polynomial or increase the number of input points
+	ErrCantInverseFunc         = errors.New("one of the derivatives is equal to zero")
+	ErrNoRoot                  = errors.New("at this interval, the function has no valid roots")
+	ErrInvalidPolynomialDegree = errors.New("invalid polynomial degree")
+	ErrInvalidNumDerivates     = errors.New("invalid num of derivates")
+	ErrPole                    = errors.New("the rational function has a pole at this point")
+	ErrInfiniteReciprocalDiff  = errors.New("the reciprocal difference is infinite")
+	ErrInvalidBoundaryCond     = errors.New("periodic boundary conditions must be set on both ends")
+	ErrNotPeriodic             = errors.New("the first and the last values of y must be equal for periodic conditions")
+	ErrSingularSystem          = linalg.ErrSingularSystem
+	ErrNotDominant             = errors.New("the system of the spline is not diagonally dominant")
+	ErrInvalidInterval         = errors.New("the start of the interval must be less than the end")
+	ErrInvalidSmoothing        = errors.New("the smoothing parameter must be non-negative")
+	ErrInvalidWeight           = errors.New("the uncertainties of the points must be positive")
+	ErrInvalidDimension        = errors.New("the points of the curve must have 2 or 3 coordinates")
+	ErrCoincidentPoints        = errors.New("consecutive points of the curve coincide")
+	ErrInvalidGrid             = errors.New("the nodes of the grid must be strictly increasing")
+	ErrOutOfGrid               = errors.New("the point is outside the grid")
+	ErrNonUniformGrid          = errors.New("the bicubic convolution requires a grid with constant steps")
+	ErrInvalidPower            = errors.New("the power of the distance must be positive")
+	ErrDuplicatePoints         = errors.New("the points must be distinct")
+	ErrInvalidKernel           = errors.New("unknown radial basis function")
+	ErrCollinearPoints         = errors.New("all the points lie on one line")
+	ErrOutsideHull             = errors.New("the point is outside the convex hull of the points")
+	ErrInvalidKnots            = errors.New("the knots must be non-decreasing and match the number of coefficients")
+)
+
+const (
+	delta    float64 = 1e-7
+	dx       float64 = 1e-5
+	UndefNum float64 = -1
+)
+
+// Inverse() Changes the data dependency y(x) to x(y).
+// If derivatives are present in the data, they are replaced with the reverse ones.
+// Before:
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+// points[i][2] - the first derivative y' (optional)
+// points[i][3] - the second derivative y” (optional)
+// After:
+// points[i][0] - y coordinate.
+// points[i][1] - x coordinate.
+// points[i][2] - the first derivative x' (optional)
+// points[i][3] - the second derivative x” (optional)
+func Inverse(points [][]float64) ([][]float64, error) {
+	result := make([][]float64, len(points))
+	for i := 0; i < len(result); i++ {
+		if len(points[i]) < 2 {
+			return nil, ErrNotEnoughInputData
+		}
+		result[i] = make([]float64, len(points[i]))
+	}
+
+	for i := 0; i < len(points); i++ {
+		result[i][0] = points[i][1]
+		result[i][1] = points[i][0]
+		if len(result[i]) > 2 {
+			if math.Abs(points[i][2]) < delta {
+				return nil, ErrCantInverseFunc
+			} else {
+				result[i][2] = 1 / points[i][2]
+			}
+		}
+		if len(result[i]) > 3 {
+			result[i][3] = -(points[i][3] / math.Pow(points[i][2], 3))
+		}
+	}
+
+	return result, nil
+}
+
+// sortedPoints() returns a copy of the first columns of the points sorted by x.
+func sortedPoints(points [][]float64, columns int) ([][]float64, error) {
+	result := make([][]float64, len(points))
+	for i := 0; i < len(points); i++ {
+		if len(points[i]) < columns {
+			return nil, ErrNotEnoughInputData
+		}
+		result[i] = make([]float64, columns)
+		copy(result[i], points[i][:columns])
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i][0] < result[j][0]
+	})
+	return result, nil
+}
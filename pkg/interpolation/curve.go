@@ -0,0 +1,215 @@
+package interpolation
+
+import "math"
+
+// Parametrization is the way to choose the values of the parameter t at the points of a curve.
+type Parametrization int
+
+const (
+	UniformParam     Parametrization = iota // ti - ti-1 = 1
+	ChordLengthParam                        // ti - ti-1 = |Pi - Pi-1|
+	CentripetalParam                        // ti - ti-1 = |Pi - Pi-1|^(1/2)
+)
+
+// Curve is the parametric cubic spline curve P(t) = (x(t), y(t)[, z(t)]) through a sequence of points.
+// Every coordinate is a spline of t: natural for open curves and periodic for closed ones.
+// A Curve is never modified after creation, so it can be evaluated from several goroutines.
+type Curve struct {
+	params []float64
+	coords []*Spline
+	closed bool
+}
+
+// CreateCurve() creates the curve through the points in the given order.
+// closed - the curve returns to the first point and is smooth there (the first point is not repeated in points).
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+// points[i][2] - z coordinate (optional, all the points must have the same dimension).
+func CreateCurve(points [][]float64, param Parametrization, closed bool) (*Curve, error) {
+	if len(points) < 2 || closed && len(points) < 3 {
+		return nil, ErrNotEnoughInputData
+	}
+	dim := len(points[0])
+	if dim < 2 || dim > 3 {
+		return nil, ErrInvalidDimension
+	}
+	for i := 1; i < len(points); i++ {
+		if len(points[i]) != dim {
+			return nil, ErrInvalidDimension
+		}
+	}
+
+	nodes := copyPoints(points)
+	if closed {
+		nodes = append(nodes, append([]float64{}, points[0]...))
+	}
+
+	params := make([]float64, len(nodes))
+	for i := 1; i < len(nodes); i++ {
+		step := distance(nodes[i-1], nodes[i])
+		if step == 0 {
+			return nil, ErrCoincidentPoints
+		}
+		switch param {
+		case UniformParam:
+			step = 1
+		case CentripetalParam:
+			step = math.Sqrt(step)
+		}
+		params[i] = params[i-1] + step
+	}
+
+	cond := NaturalCond()
+	if closed {
+		cond = PeriodicCond()
+	}
+	curve := &Curve{params: params, coords: make([]*Spline, dim), closed: closed}
+	for k := 0; k < dim; k++ {
+		table := make([][]float64, len(nodes))
+		for i := 0; i < len(nodes); i++ {
+			table[i] = []float64{params[i], nodes[i][k]}
+		}
+		spline, err := fitSpline(table, cond, cond)
+		if err != nil {
+			return nil, err
+		}
+		curve.coords[k] = spline
+	}
+
+	return curve, nil
+}
+
+// Params() returns a copy of the values of the parameter at the points (and at the repeated first point if closed).
+func (c *Curve) Params() []float64 {
+	return append([]float64{}, c.params...)
+}
+
+// Domain() returns the first and the last values of the parameter.
+func (c *Curve) Domain() (float64, float64) {
+	return c.params[0], c.params[len(c.params)-1]
+}
+
+// Point() calculates P(t). The parameter of a closed curve is taken modulo the period.
+func (c *Curve) Point(t float64) []float64 {
+	t = c.wrap(t)
+	point := make([]float64, len(c.coords))
+	for k := 0; k < len(c.coords); k++ {
+		point[k] = c.coords[k].Calc(t)
+	}
+	return point
+}
+
+// Derivative() calculates the derivative P'(t).
+func (c *Curve) Derivative(t float64) []float64 {
+	return c.derivative(c.wrap(t), 1)
+}
+
+// Tangent() calculates the unit tangent vector P'(t) / |P'(t)|.
+func (c *Curve) Tangent(t float64) []float64 {
+	d := c.Derivative(t)
+	norm := math.Sqrt(dot(d, d))
+	for k := 0; k < len(d); k++ {
+		d[k] /= norm
+	}
+	return d
+}
+
+// Curvature() calculates the curvature sqrt(|P'|^2 |P”|^2 - (P', P”)^2) / |P'|^3 at t (the radius is 1 / curvature).
+func (c *Curve) Curvature(t float64) float64 {
+	t = c.wrap(t)
+	d1, d2 := c.derivative(t, 1), c.derivative(t, 2)
+	speed2 := dot(d1, d1)
+	return math.Sqrt(math.Max(0, speed2*dot(d2, d2)-dot(d1, d2)*dot(d1, d2))) / math.Pow(speed2, 1.5)
+}
+
+// ArcLength() calculates the length of the curve between the parameters a and b (a <= b).
+// The parameters of a closed curve may go around it several times.
+func (c *Curve) ArcLength(a, b float64) (float64, error) {
+	if a > b {
+		return UndefNum, ErrInvalidInterval
+	}
+	if !c.closed {
+		return c.length(a, b), nil
+	}
+	return c.unwrappedLength(b) - c.unwrappedLength(a), nil
+}
+
+// Length() calculates the length of the whole curve.
+func (c *Curve) Length() float64 {
+	return c.length(c.Domain())
+}
+
+// unwrappedLength() calculates the length of a closed curve from the first point to t along the curve.
+func (c *Curve) unwrappedLength(t float64) float64 {
+	start, end := c.Domain()
+	turns := math.Floor((t - start) / (end - start))
+	return turns*c.Length() + c.length(start, c.wrap(t))
+}
+
+// length() calculates the length of the curve between a and b (a <= b)
+// by the 5-point Gauss-Legendre quadrature of |P'(t)| on every segment between the points.
+func (c *Curve) length(a, b float64) float64 {
+	nodes := []float64{a}
+	for _, t := range c.params {
+		if t > a && t < b {
+			nodes = append(nodes, t)
+		}
+	}
+	nodes = append(nodes, b)
+
+	var result float64
+	for i := 1; i < len(nodes); i++ {
+		result += gaussLegendre(func(t float64) float64 {
+			d := c.derivative(t, 1)
+			return math.Sqrt(dot(d, d))
+		}, nodes[i-1], nodes[i])
+	}
+	return result
+}
+
+// wrap() reduces the parameter of a closed curve to the domain.
+func (c *Curve) wrap(t float64) float64 {
+	if !c.closed {
+		return t
+	}
+	start, end := c.Domain()
+	return start + math.Mod(math.Mod(t-start, end-start)+end-start, end-start)
+}
+
+// derivative() calculates the derivative of the given order of every coordinate at t.
+func (c *Curve) derivative(t float64, order int) []float64 {
+	result := make([]float64, len(c.coords))
+	for k := 0; k < len(c.coords); k++ {
+		result[k] = c.coords[k].derivative(t, order)
+	}
+	return result
+}
+
+// gaussLegendre() integrates f on [a, b] by the 5-point Gauss-Legendre quadrature.
+func gaussLegendre(f func(float64) float64, a, b float64) float64 {
+	nodes := [5]float64{0, -0.5384693101056831, 0.5384693101056831, -0.9061798459386640, 0.9061798459386640}
+	weights := [5]float64{0.5688888888888889, 0.4786286704993665, 0.4786286704993665, 0.2369268850561891, 0.2369268850561891}
+
+	center, half := (a+b)/2, (b-a)/2
+	var result float64
+	for i := 0; i < len(nodes); i++ {
+		result += weights[i] * f(center+half*nodes[i])
+	}
+	return result * half
+}
+
+func dot(a, b []float64) float64 {
+	var result float64
+	for i := 0; i < len(a); i++ {
+		result += a[i] * b[i]
+	}
+	return result
+}
+
+func distance(a, b []float64) float64 {
+	var result float64
+	for i := 0; i < len(a); i++ {
+		result += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return math.Sqrt(result)
+}
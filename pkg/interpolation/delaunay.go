@@ -0,0 +1,425 @@
+package interpolation
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Triangulation is the Delaunay triangulation of scattered points z(x, y).
+// The interpolation inside a triangle is linear (by the barycentric coordinates)
+// or the Clough-Tocher C1 cubic; outside the convex hull of the points it is not defined.
+// A Triangulation is never modified after creation, so it can be evaluated from several goroutines.
+type Triangulation struct {
+	points    [][]float64
+	triangles [][3]int // the indices of the vertices counterclockwise
+	gradients [][2]float64
+}
+
+// CreateTriangulation() creates the Delaunay triangulation by the Bowyer-Watson algorithm.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+// points[i][2] - z coordinate.
+func CreateTriangulation(points [][]float64) (*Triangulation, error) {
+	copied, err := scatteredPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+	if !distinct(copied) {
+		return nil, ErrDuplicatePoints
+	}
+
+	t := &Triangulation{points: copied, triangles: bowyerWatson(copied)}
+	if len(t.triangles) == 0 {
+		return nil, ErrCollinearPoints
+	}
+	t.gradients = t.estimateGradients()
+	return t, nil
+}
+
+// Points() returns a copy of the vertices.
+func (t *Triangulation) Points() [][]float64 {
+	return copyPoints(t.points)
+}
+
+// Triangles() returns a copy of the triangles, the indices of the vertices counterclockwise.
+func (t *Triangulation) Triangles() [][3]int {
+	return append([][3]int{}, t.triangles...)
+}
+
+// Linear() calculates z(x, y) by the linear interpolation on the triangle containing the point.
+func (t *Triangulation) Linear(x, y float64) (float64, error) {
+	index, b, err := t.locate(x, y)
+	if err != nil {
+		return UndefNum, err
+	}
+	tr := t.triangles[index]
+	return b[0]*t.points[tr[0]][2] + b[1]*t.points[tr[1]][2] + b[2]*t.points[tr[2]][2], nil
+}
+
+// CloughTocher() calculates z(x, y) by the Clough-Tocher interpolation: every triangle is split
+// into three by its centroid and the cubic Bezier patches on them make the surface C1.
+// The gradients at the vertices are estimated by estimateGradients().
+func (t *Triangulation) CloughTocher(x, y float64) (float64, error) {
+	index, b, err := t.locate(x, y)
+	if err != nil {
+		return UndefNum, err
+	}
+	control := t.cloughTocherNet(t.triangles[index])
+
+	// The micro triangle (Vp, Vq, V0) is the one opposite to the smallest barycentric coordinate r.
+	r := 0
+	for k := 1; k < 3; k++ {
+		if b[k] < b[r] {
+			r = k
+		}
+	}
+	p, q := (r+1)%3, (r+2)%3
+	u, v, w := b[p]-b[r], b[q]-b[r], 3*b[r]
+
+	var result float64
+	for i := 0; i <= 3; i++ {
+		for j := 0; i+j <= 3; j++ {
+			k := 3 - i - j
+			var key [4]int
+			key[p], key[q], key[3] = i, j, k
+			coef := 6 / (factorial(i) * factorial(j) * factorial(k))
+			result += coef * math.Pow(u, float64(i)) * math.Pow(v, float64(j)) * math.Pow(w, float64(k)) * control[key]
+		}
+	}
+	return result, nil
+}
+
+// WriteOFF() writes the triangulation in the OFF format: the vertices x y z and the triangles.
+func (t *Triangulation) WriteOFF(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+	fmt.Fprintf(buf, "OFF\n%d %d 0\n", len(t.points), len(t.triangles))
+	for _, point := range t.points {
+		fmt.Fprintf(buf, "%g %g %g\n", point[0], point[1], point[2])
+	}
+	for _, tr := range t.triangles {
+		fmt.Fprintf(buf, "3 %d %d %d\n", tr[0], tr[1], tr[2])
+	}
+	return buf.Flush()
+}
+
+// WriteCSV() writes the triangles as CSV: one row x1,y1,z1,x2,y2,z2,x3,y3,z3 for every triangle.
+func (t *Triangulation) WriteCSV(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+	fmt.Fprintln(buf, "x1,y1,z1,x2,y2,z2,x3,y3,z3")
+	for _, tr := range t.triangles {
+		for k, index := range tr {
+			if k > 0 {
+				fmt.Fprint(buf, ",")
+			}
+			point := t.points[index]
+			fmt.Fprintf(buf, "%g,%g,%g", point[0], point[1], point[2])
+		}
+		fmt.Fprintln(buf)
+	}
+	return buf.Flush()
+}
+
+// locate() returns the index of the triangle containing the point and the barycentric coordinates of the point.
+func (t *Triangulation) locate(x, y float64) (int, [3]float64, error) {
+	const eps = 1e-12
+	for index, tr := range t.triangles {
+		b := barycentric(t.points[tr[0]], t.points[tr[1]], t.points[tr[2]], x, y)
+		if b[0] >= -eps && b[1] >= -eps && b[2] >= -eps {
+			return index, b, nil
+		}
+	}
+	return -1, [3]float64{}, ErrOutsideHull
+}
+
+// estimateGradients() estimates the gradients at the vertices by the mean of the gradients
+// of the linear functions on the adjacent triangles weighted by their areas.
+func (t *Triangulation) estimateGradients() [][2]float64 {
+	gradients := make([][2]float64, len(t.points))
+	weights := make([]float64, len(t.points))
+	for _, tr := range t.triangles {
+		a, b, c := t.points[tr[0]], t.points[tr[1]], t.points[tr[2]]
+		area := cross(a, b, c) / 2
+		// The gradient of the plane through a, b, c.
+		gx := ((b[2]-a[2])*(c[1]-a[1]) - (c[2]-a[2])*(b[1]-a[1])) / (2 * area)
+		gy := ((c[2]-a[2])*(b[0]-a[0]) - (b[2]-a[2])*(c[0]-a[0])) / (2 * area)
+		for _, index := range tr {
+			gradients[index][0] += area * gx
+			gradients[index][1] += area * gy
+			weights[index] += area
+		}
+	}
+	for i := 0; i < len(gradients); i++ {
+		if weights[i] > 0 {
+			gradients[i][0] /= weights[i]
+			gradients[i][1] /= weights[i]
+		}
+	}
+	return gradients
+}
+
+// cloughTocherNet() calculates the Bezier control points of the Clough-Tocher patches of the triangle.
+// The key - the powers of the barycentric coordinates of V1, V2, V3 and the centroid V0.
+// The derivative across every outer edge is linear along it, so the patches of the neighbouring triangles are C1.
+func (t *Triangulation) cloughTocherNet(tr [3]int) map[[4]int]float64 {
+	var v [3][]float64
+	var g [3][2]float64
+	for k := 0; k < 3; k++ {
+		v[k], g[k] = t.points[tr[k]], t.gradients[tr[k]]
+	}
+	centroid := []float64{(v[0][0] + v[1][0] + v[2][0]) / 3, (v[0][1] + v[1][1] + v[2][1]) / 3}
+	along := func(k int, to []float64) float64 {
+		return v[k][2] + (g[k][0]*(to[0]-v[k][0])+g[k][1]*(to[1]-v[k][1]))/3
+	}
+	key := func(powers ...[2]int) [4]int {
+		var result [4]int
+		for _, power := range powers {
+			result[power[0]] = power[1]
+		}
+		return result
+	}
+
+	c := map[[4]int]float64{}
+	for k := 0; k < 3; k++ {
+		next, prev := (k+1)%3, (k+2)%3
+		c[key([2]int{k, 3})] = v[k][2]
+		c[key([2]int{k, 2}, [2]int{next, 1})] = along(k, v[next])
+		c[key([2]int{k, 2}, [2]int{prev, 1})] = along(k, v[prev])
+		c[key([2]int{k, 2}, [2]int{3, 1})] = along(k, centroid)
+	}
+
+	// The points next to the middles of the outer edges.
+	for k := 0; k < 3; k++ {
+		next := (k + 1) % 3
+		middle := []float64{(v[k][0] + v[next][0]) / 2, (v[k][1] + v[next][1]) / 2}
+		edge := []float64{v[next][0] - v[k][0], v[next][1] - v[k][1]}
+		beta := ((centroid[0]-middle[0])*edge[0] + (centroid[1]-middle[1])*edge[1]) / (edge[0]*edge[0] + edge[1]*edge[1])
+
+		c30, c21 := c[key([2]int{k, 3})], c[key([2]int{k, 2}, [2]int{next, 1})]
+		c12, c03 := c[key([2]int{k, 1}, [2]int{next, 2})], c[key([2]int{next, 3})]
+		d0 := c[key([2]int{k, 2}, [2]int{3, 1})] - (c30+c21)/2
+		d2 := c[key([2]int{next, 2}, [2]int{3, 1})] - (c12+c03)/2
+		t0, t1, t2 := c21-c30, c12-c21, c03-c12
+		d1 := (d0+d2)/2 + beta*(t1-(t0+t2)/2)
+		c[key([2]int{k, 1}, [2]int{next, 1}, [2]int{3, 1})] = d1 + (c21+c12)/2
+	}
+
+	// The inner points, the surface is C1 across the edges between the micro triangles.
+	var centre float64
+	for k := 0; k < 3; k++ {
+		next, prev := (k+1)%3, (k+2)%3
+		value := (c[key([2]int{k, 2}, [2]int{3, 1})] +
+			c[key([2]int{k, 1}, [2]int{next, 1}, [2]int{3, 1})] +
+			c[key([2]int{k, 1}, [2]int{prev, 1}, [2]int{3, 1})]) / 3
+		c[key([2]int{k, 1}, [2]int{3, 2})] = value
+		centre += value / 3
+	}
+	c[key([2]int{3, 3})] = centre
+
+	return c
+}
+
+// bowyerWatson() builds the Delaunay triangles by inserting the points one by one.
+// The hull is closed by the ghost triangles (a, b, ghost) with the vertex at infinity, so no super triangle
+// is needed and no triangles at the hull are lost. Returns nil if all the points are collinear.
+func bowyerWatson(points [][]float64) [][3]int {
+	xmin, xmax, ymin, ymax := points[0][0], points[0][0], points[0][1], points[0][1]
+	for _, point := range points {
+		xmin, xmax = min(xmin, point[0]), max(xmax, point[0])
+		ymin, ymax = min(ymin, point[1]), max(ymax, point[1])
+	}
+	size := max(xmax-xmin, ymax-ymin)
+	m := &mesh{points: points, tol: 1e-12 * size * size, edges: map[[2]int]int{}}
+
+	third := -1
+	for k := 2; k < len(points) && third < 0; k++ {
+		if math.Abs(cross(points[0], points[1], points[k])) > m.tol {
+			third = k
+		}
+	}
+	if third < 0 {
+		return nil
+	}
+	a, b := 0, 1
+	if cross(points[a], points[b], points[third]) < 0 {
+		a, b = b, a
+	}
+	m.add([3]int{a, b, third})
+	m.add([3]int{b, a, ghost})
+	m.add([3]int{third, b, ghost})
+	m.add([3]int{a, third, ghost})
+
+	for i := 2; i < len(points); i++ {
+		if i != third {
+			m.insert(i)
+		}
+	}
+
+	result := [][3]int{}
+	for index, tr := range m.triangles {
+		if m.alive[index] && tr[2] != ghost {
+			result = append(result, tr)
+		}
+	}
+	return result
+}
+
+// ghost is the vertex at infinity of the triangles outside the hull.
+const ghost = -1
+
+// mesh is the triangulation being built: the real triangles are counterclockwise, the ghost triangle (a, b, ghost)
+// lies to the left of its hull edge a -> b. Every directed edge belongs to one triangle and its twin to the neighbour.
+type mesh struct {
+	points    [][]float64
+	tol       float64 // the doubled area below which three points are collinear
+	triangles [][3]int
+	alive     []bool
+	edges     map[[2]int]int // the directed edge -> the index of its triangle
+}
+
+func (m *mesh) add(tr [3]int) {
+	m.triangles = append(m.triangles, tr)
+	m.alive = append(m.alive, true)
+	for k := 0; k < 3; k++ {
+		m.edges[[2]int{tr[k], tr[(k+1)%3]}] = len(m.triangles) - 1
+	}
+}
+
+func (m *mesh) remove(index int) {
+	m.alive[index] = false
+	tr := m.triangles[index]
+	for k := 0; k < 3; k++ {
+		delete(m.edges, [2]int{tr[k], tr[(k+1)%3]})
+	}
+}
+
+// insert() replaces the cavity of the triangles conflicting with the point by the fan of the triangles around it.
+// The cavity is grown from the triangle containing the point across the edges, so it is connected,
+// and then extended until every boundary edge is seen from the point: for the cocircular points the circle tests
+// can disagree, and the fan over a cavity that is not star-shaped would overlap the other triangles.
+func (m *mesh) insert(i int) {
+	p := m.points[i]
+	seed, farthest := -1, 0.0
+	for index, tr := range m.triangles {
+		if !m.alive[index] {
+			continue
+		}
+		if tr[2] != ghost {
+			a, b, c := m.points[tr[0]], m.points[tr[1]], m.points[tr[2]]
+			if cross(a, b, p) >= -m.tol && cross(b, c, p) >= -m.tol && cross(c, a, p) >= -m.tol {
+				seed = index
+				break
+			}
+		} else if d := cross(m.points[tr[0]], m.points[tr[1]], p); d > farthest {
+			seed, farthest = index, d
+		}
+	}
+	if seed < 0 {
+		return
+	}
+
+	cavity := []int{seed}
+	inCavity := map[int]bool{seed: true}
+	for k := 0; k < len(cavity); k++ {
+		tr := m.triangles[cavity[k]]
+		for e := 0; e < 3; e++ {
+			other := m.edges[[2]int{tr[(e+1)%3], tr[e]}]
+			if !inCavity[other] && m.conflicts(m.triangles[other], p) {
+				cavity = append(cavity, other)
+				inCavity[other] = true
+			}
+		}
+	}
+	for k := 0; k < len(cavity); k++ {
+		tr := m.triangles[cavity[k]]
+		for e := 0; e < 3; e++ {
+			u, v := tr[e], tr[(e+1)%3]
+			other := m.edges[[2]int{v, u}]
+			if !inCavity[other] && u != ghost && v != ghost && cross(m.points[u], m.points[v], p) <= m.tol {
+				cavity = append(cavity, other)
+				inCavity[other] = true
+				k = -1 // the boundary has changed, check it again
+				break
+			}
+		}
+	}
+
+	var boundary [][2]int
+	for _, index := range cavity {
+		tr := m.triangles[index]
+		for e := 0; e < 3; e++ {
+			u, v := tr[e], tr[(e+1)%3]
+			if !inCavity[m.edges[[2]int{v, u}]] {
+				boundary = append(boundary, [2]int{u, v})
+			}
+		}
+	}
+	for _, index := range cavity {
+		m.remove(index)
+	}
+	for _, edge := range boundary {
+		switch {
+		case edge[1] == ghost:
+			m.add([3]int{i, edge[0], ghost})
+		case edge[0] == ghost:
+			m.add([3]int{edge[1], i, ghost})
+		default:
+			m.add([3]int{edge[0], edge[1], i})
+		}
+	}
+}
+
+// conflicts() reports whether the point is inside the circumcircle of the triangle. The circumcircle of the ghost
+// triangle is the half-plane to the left of its edge, with the open edge itself.
+func (m *mesh) conflicts(tr [3]int, p []float64) bool {
+	a, b := m.points[tr[0]], m.points[tr[1]]
+	if tr[2] != ghost {
+		return inCircumcircle(a, b, m.points[tr[2]], p[0], p[1])
+	}
+	d := cross(a, b, p)
+	if math.Abs(d) <= m.tol {
+		along := (p[0]-a[0])*(b[0]-a[0]) + (p[1]-a[1])*(b[1]-a[1])
+		return along > 0 && along < (b[0]-a[0])*(b[0]-a[0])+(b[1]-a[1])*(b[1]-a[1])
+	}
+	return d > 0
+}
+
+// inCircumcircle() reports whether (x, y) is inside the circumcircle of the counterclockwise triangle a, b, c.
+func inCircumcircle(a, b, c []float64, x, y float64) bool {
+	ax, ay := a[0]-x, a[1]-y
+	bx, by := b[0]-x, b[1]-y
+	cx, cy := c[0]-x, c[1]-y
+	det := (ax*ax+ay*ay)*(bx*cy-cx*by) - (bx*bx+by*by)*(ax*cy-cx*ay) + (cx*cx+cy*cy)*(ax*by-bx*ay)
+	return det > 0
+}
+
+// cross() returns the doubled signed area of the triangle a, b, c (positive if counterclockwise).
+func cross(a, b, c []float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+// barycentric() calculates the barycentric coordinates of (x, y) in the triangle a, b, c.
+func barycentric(a, b, c []float64, x, y float64) [3]float64 {
+	p := []float64{x, y}
+	area := cross(a, b, c)
+	return [3]float64{cross(p, b, c) / area, cross(a, p, c) / area, cross(a, b, p) / area}
+}
+
+func factorial(n int) float64 {
+	result := 1.0
+	for i := 2; i <= n; i++ {
+		result *= float64(i)
+	}
+	return result
+}
+
+// LinearTriangulationAdapter() returns a Surface that evaluates the linear interpolation on the triangles.
+func LinearTriangulationAdapter(t *Triangulation) Surface {
+	return &surfaceAdapter{eval: t.Linear, name: "Delaunay linear"}
+}
+
+// CloughTocherAdapter() returns a Surface that evaluates the Clough-Tocher interpolation on the triangles.
+func CloughTocherAdapter(t *Triangulation) Surface {
+	return &surfaceAdapter{eval: t.CloughTocher, name: "Clough-Tocher"}
+}
@@ -0,0 +1,214 @@
+package interpolation
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func randomPoints(seed int64, n int, f func(x, y float64) float64) [][]float64 {
+	r := rand.New(rand.NewSource(seed))
+	points := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		x, y := r.Float64()*4-2, r.Float64()*4-2
+		points[i] = []float64{x, y, f(x, y)}
+	}
+	return points
+}
+
+func TestTriangulationEmptyCircumcircle(t *testing.T) {
+	zero := func(x, y float64) float64 { return 0 }
+	for seed := int64(1); seed <= 5; seed++ {
+		tr, err := CreateTriangulation(randomPoints(seed, 200, zero))
+		if err != nil {
+			t.Fatal(err)
+		}
+		points := tr.Points()
+		for _, triangle := range tr.Triangles() {
+			a, b, c := points[triangle[0]], points[triangle[1]], points[triangle[2]]
+			// The circumcentre from |p - a| == |p - b| == |p - c|.
+			d := 2 * cross(a, b, c)
+			sa, sb, sc := a[0]*a[0]+a[1]*a[1], b[0]*b[0]+b[1]*b[1], c[0]*c[0]+c[1]*c[1]
+			cx := (sa*(b[1]-c[1]) + sb*(c[1]-a[1]) + sc*(a[1]-b[1])) / d
+			cy := (sa*(c[0]-b[0]) + sb*(a[0]-c[0]) + sc*(b[0]-a[0])) / d
+			radius := math.Hypot(a[0]-cx, a[1]-cy)
+
+			for i, p := range points {
+				if i == triangle[0] || i == triangle[1] || i == triangle[2] {
+					continue
+				}
+				if math.Hypot(p[0]-cx, p[1]-cy) < radius*(1-1e-9) {
+					t.Fatalf("seed %d: the point %d is inside the circumcircle of %v", seed, i, triangle)
+				}
+			}
+		}
+	}
+}
+
+// hull() returns the vertices of the convex hull counterclockwise (the monotone chain) and the number of the points
+// on its boundary, with the points inside the edges.
+func hull(points [][]float64) ([][]float64, int) {
+	sorted := copyPoints(points)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i][0] != sorted[j][0] {
+			return sorted[i][0] < sorted[j][0]
+		}
+		return sorted[i][1] < sorted[j][1]
+	})
+	var chain [][]float64
+	for pass := 0; pass < 2; pass++ {
+		start := len(chain)
+		for _, p := range sorted {
+			for len(chain) >= start+2 && cross(chain[len(chain)-2], chain[len(chain)-1], p) <= 0 {
+				chain = chain[:len(chain)-1]
+			}
+			chain = append(chain, p)
+		}
+		chain = chain[:len(chain)-1]
+		slices.Reverse(sorted)
+	}
+
+	var boundary int
+	for _, p := range points {
+		for k := range chain {
+			a, b := chain[k], chain[(k+1)%len(chain)]
+			along := (p[0]-a[0])*(b[0]-a[0]) + (p[1]-a[1])*(b[1]-a[1])
+			if math.Abs(cross(a, b, p)) <= 1e-9 && along >= 0 && along < (b[0]-a[0])*(b[0]-a[0])+(b[1]-a[1])*(b[1]-a[1]) {
+				boundary++
+				break
+			}
+		}
+	}
+	return chain, boundary
+}
+
+func TestTriangulationCoversHull(t *testing.T) {
+	zero := func(x, y float64) float64 { return 0 }
+	cases := map[string][][]float64{
+		"random": randomPoints(3, 300, zero),
+	}
+	polygon := func(n int) [][]float64 {
+		points := make([][]float64, n)
+		for i := 0; i < n; i++ {
+			angle := 2 * math.Pi * float64(i) / float64(n)
+			points[i] = []float64{math.Cos(angle), math.Sin(angle), 0}
+		}
+		return points
+	}
+	cases["octagon"], cases["circle"] = polygon(8), polygon(200)
+	r := rand.New(rand.NewSource(4))
+	for i := 0; i < 100; i++ {
+		cases["strip"] = append(cases["strip"], []float64{100 * r.Float64(), 0.5 * r.Float64(), 0})
+		x := float64(i) / 99
+		cases["parabola"] = append(cases["parabola"], []float64{x, x * x, 0})
+		cases["grid"] = append(cases["grid"], []float64{float64(i % 10), float64(i / 10), 0})
+	}
+
+	for name, points := range cases {
+		tr, err := CreateTriangulation(points)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		vertices, boundary := hull(points)
+		var hullArea, area float64
+		for k := range vertices {
+			hullArea += cross(vertices[0], vertices[k], vertices[(k+1)%len(vertices)]) / 2
+		}
+		for _, triangle := range tr.triangles {
+			area += cross(tr.points[triangle[0]], tr.points[triangle[1]], tr.points[triangle[2]]) / 2
+		}
+		// The triangles cover the hull without overlapping only if the areas are equal and every point is a vertex.
+		if math.Abs(area-hullArea) > 1e-9*hullArea {
+			t.Errorf("%s: the area of the triangles %g, the area of the hull %g", name, area, hullArea)
+		}
+		if expected := 2*len(points) - 2 - boundary; len(tr.triangles) != expected {
+			t.Errorf("%s: %d triangles, expected %d", name, len(tr.triangles), expected)
+		}
+	}
+}
+
+func TestCloughTocherQuadratic(t *testing.T) {
+	f := func(x, y float64) float64 { return 1 + 2*x - 3*y + 0.5*x*x - x*y + 2*y*y }
+	tr, err := CreateTriangulation(randomPoints(7, 50, f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The estimated gradients are exact only for the linear functions, the exact ones are set for the check.
+	for i, p := range tr.points {
+		tr.gradients[i] = [2]float64{2 + p[0] - p[1], -3 - p[0] + 4*p[1]}
+	}
+
+	r := rand.New(rand.NewSource(8))
+	for i := 0; i < 1000; i++ {
+		x, y := r.Float64()*4-2, r.Float64()*4-2
+		z, err := tr.CloughTocher(x, y)
+		if err == ErrOutsideHull {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(z-f(x, y)) > 1e-10 {
+			t.Fatalf("CloughTocher(%g, %g) = %g, expected %g", x, y, z, f(x, y))
+		}
+	}
+}
+
+func TestCloughTocherC1AcrossEdges(t *testing.T) {
+	f := func(x, y float64) float64 { return math.Sin(x) * math.Exp(y/2) }
+	// The jittered grid has no thin triangles, whose large curvature would spoil the differences.
+	r := rand.New(rand.NewSource(9))
+	var points [][]float64
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 6; j++ {
+			x, y := -2+0.8*float64(i)+0.2*r.Float64(), -2+0.8*float64(j)+0.2*r.Float64()
+			points = append(points, []float64{x, y, f(x, y)})
+		}
+	}
+	tr, err := CreateTriangulation(points)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edges := map[[2]int]int{}
+	for _, triangle := range tr.triangles {
+		for k := 0; k < 3; k++ {
+			a, b := triangle[k], triangle[(k+1)%3]
+			edges[[2]int{min(a, b), max(a, b)}]++
+		}
+	}
+
+	const h = 1e-6
+	across := func(x, y, nx, ny float64) float64 {
+		var z [3]float64
+		for k := 0; k < 3; k++ {
+			var err error
+			if z[k], err = tr.CloughTocher(x+float64(k)*h*nx, y+float64(k)*h*ny); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return (-3*z[0] + 4*z[1] - z[2]) / (2 * h)
+	}
+	var checked int
+	for edge, count := range edges {
+		if count != 2 {
+			continue
+		}
+		a, b := tr.points[edge[0]], tr.points[edge[1]]
+		length := math.Hypot(b[0]-a[0], b[1]-a[1])
+		nx, ny := (a[1]-b[1])/length, (b[0]-a[0])/length
+		for _, s := range []float64{0.2, 0.5, 0.7} {
+			x, y := a[0]+s*(b[0]-a[0]), a[1]+s*(b[1]-a[1])
+			// The one-sided derivatives across the edge differ by O(h^2) only if the surface is C1.
+			if right, left := across(x, y, nx, ny), -across(x, y, -nx, -ny); math.Abs(right-left) > 1e-6*(1+math.Abs(right)) {
+				t.Fatalf("the derivatives across the edge %v differ: %g and %g", edge, right, left)
+			}
+			checked++
+		}
+	}
+	if checked == 0 {
+		t.Fatal("no inner edges")
+	}
+}
@@ -0,0 +1,260 @@
+package interpolation
+
+import (
+	"math"
+	"sort"
+)
+
+// Grid is the table of the function z(x, y) on a rectangular grid.
+// z[j][i] - the value at (xs[i], ys[j]), xs and ys are strictly increasing.
+// A Grid is never modified after creation, so it can be evaluated from several goroutines.
+type Grid struct {
+	xs      []float64
+	ys      []float64
+	z       [][]float64
+	uniform bool // the steps along x and along y are constant
+}
+
+// CreateGrid() creates the grid by the nodes and the values, the slices are copied.
+// z[j][i] - the value at (xs[i], ys[j]).
+func CreateGrid(xs, ys []float64, z [][]float64) (*Grid, error) {
+	if len(xs) < 2 || len(ys) < 2 || len(z) != len(ys) {
+		return nil, ErrNotEnoughInputData
+	}
+	if !increasing(xs) || !increasing(ys) {
+		return nil, ErrInvalidGrid
+	}
+
+	grid := &Grid{
+		xs: append([]float64{}, xs...),
+		ys: append([]float64{}, ys...),
+		z:  make([][]float64, len(ys)),
+	}
+	for j := 0; j < len(ys); j++ {
+		if len(z[j]) != len(xs) {
+			return nil, ErrNotEnoughInputData
+		}
+		grid.z[j] = append([]float64{}, z[j]...)
+	}
+	grid.uniform = uniform(xs) && uniform(ys)
+	return grid, nil
+}
+
+// CreateGridFromTable() creates the grid by the table in the CSV layout:
+// table[0][1:] - the nodes x, table[j][0] - the nodes y, table[j][i] - z(table[0][i], table[j][0]), table[0][0] is not used.
+func CreateGridFromTable(table [][]float64) (*Grid, error) {
+	if len(table) < 3 || len(table[0]) < 3 {
+		return nil, ErrNotEnoughInputData
+	}
+
+	ys := make([]float64, len(table)-1)
+	z := make([][]float64, len(table)-1)
+	for j := 1; j < len(table); j++ {
+		if len(table[j]) != len(table[0]) {
+			return nil, ErrNotEnoughInputData
+		}
+		ys[j-1] = table[j][0]
+		z[j-1] = table[j][1:]
+	}
+	return CreateGrid(table[0][1:], ys, z)
+}
+
+// Domain() returns the bounds of the grid: xmin, xmax, ymin, ymax.
+func (g *Grid) Domain() (float64, float64, float64, float64) {
+	return g.xs[0], g.xs[len(g.xs)-1], g.ys[0], g.ys[len(g.ys)-1]
+}
+
+// Bilinear() calculates z(x, y) by the bilinear interpolation on the cell containing the point.
+func (g *Grid) Bilinear(x, y float64) (float64, error) {
+	if !g.contains(x, y) {
+		return UndefNum, ErrOutOfGrid
+	}
+	i, tx := cell(g.xs, x)
+	j, ty := cell(g.ys, y)
+
+	bottom := (1-tx)*g.z[j][i] + tx*g.z[j][i+1]
+	top := (1-tx)*g.z[j+1][i] + tx*g.z[j+1][i+1]
+	return (1-ty)*bottom + ty*top, nil
+}
+
+// Bicubic() calculates z(x, y) by the bicubic convolution (Keys, a = -0.5) on 4 x 4 nodes around the point.
+// The kernel works with the indices of the nodes, so the grid must be uniform (ErrNonUniformGrid otherwise);
+// the nodes outside the grid are extrapolated by outer().
+func (g *Grid) Bicubic(x, y float64) (float64, error) {
+	if !g.uniform {
+		return UndefNum, ErrNonUniformGrid
+	}
+	if !g.contains(x, y) {
+		return UndefNum, ErrOutOfGrid
+	}
+	i, tx := cell(g.xs, x)
+	j, ty := cell(g.ys, y)
+
+	var columns [4]float64
+	for dj := -1; dj <= 2; dj++ {
+		var row [4]float64
+		for di := -1; di <= 2; di++ {
+			row[di+1] = g.extended(i+di, j+dj)
+		}
+		columns[dj+1] = convolution(row, tx)
+	}
+	return convolution(columns, ty), nil
+}
+
+// extended() returns the value at the node (i, j), the nodes outside the grid are extrapolated.
+func (g *Grid) extended(i, j int) float64 {
+	nx, ny := len(g.xs), len(g.ys)
+	switch {
+	case i < 0:
+		return outer(g.extended(0, j), g.extended(1, j), g.extended(2%nx, j), nx)
+	case i >= nx:
+		return outer(g.extended(nx-1, j), g.extended(nx-2, j), g.extended(max(nx-3, 0), j), nx)
+	case j < 0:
+		return outer(g.z[0][i], g.z[1][i], g.z[2%ny][i], ny)
+	case j >= ny:
+		return outer(g.z[ny-1][i], g.z[ny-2][i], g.z[max(ny-3, 0)][i], ny)
+	}
+	return g.z[j][i]
+}
+
+// outer() extrapolates the value before f0 by f-1 = 3f0 - 3f1 + f2 (2f0 - f1 if there are only n = 2 nodes).
+func outer(f0, f1, f2 float64, n int) float64 {
+	if n < 3 {
+		return 2*f0 - f1
+	}
+	return 3*f0 - 3*f1 + f2
+}
+
+// Uniform() reports whether the steps of the grid along x and along y are constant (required by Bicubic()).
+func (g *Grid) Uniform() bool {
+	return g.uniform
+}
+
+// contains() reports whether the point is inside the grid.
+func (g *Grid) contains(x, y float64) bool {
+	xmin, xmax, ymin, ymax := g.Domain()
+	return x >= xmin && x <= xmax && y >= ymin && y <= ymax
+}
+
+// SplineSurface is the tensor product cubic spline on a grid: z(x, y) is calculated by the natural splines
+// along x on every row of the grid and then by the natural spline along y through their values.
+// The spline along y depends linearly on the values, so it is built once for every coefficient
+// of every segment of the rows, and Calc() only evaluates the splines.
+type SplineSurface struct {
+	grid    *Grid
+	columns [][]*Spline // columns[i][k] - the spline along y of the coefficient k on the segment [xs[i], xs[i+1]]
+}
+
+// CreateSplineSurface() creates the spline surface on the grid.
+func CreateSplineSurface(grid *Grid) (*SplineSurface, error) {
+	rows := make([]*Spline, len(grid.ys))
+	for j := 0; j < len(grid.ys); j++ {
+		row := make([][]float64, len(grid.xs))
+		for i := 0; i < len(grid.xs); i++ {
+			row[i] = []float64{grid.xs[i], grid.z[j][i]}
+		}
+		spline, err := fitSpline(row, NaturalCond(), NaturalCond())
+		if err != nil {
+			return nil, err
+		}
+		rows[j] = spline
+	}
+
+	surface := &SplineSurface{grid: grid, columns: make([][]*Spline, len(grid.xs)-1)}
+	for i := 0; i < len(grid.xs)-1; i++ {
+		surface.columns[i] = make([]*Spline, 4)
+		for k := 0; k < 4; k++ {
+			column := make([][]float64, len(grid.ys))
+			for j := 0; j < len(grid.ys); j++ {
+				column[j] = []float64{grid.ys[j], rows[j].config[i+1][k]}
+			}
+			spline, err := fitSpline(column, NaturalCond(), NaturalCond())
+			if err != nil {
+				return nil, err
+			}
+			surface.columns[i][k] = spline
+		}
+	}
+	return surface, nil
+}
+
+// Calc() calculates z(x, y).
+func (s *SplineSurface) Calc(x, y float64) (float64, error) {
+	if !s.grid.contains(x, y) {
+		return UndefNum, ErrOutOfGrid
+	}
+
+	i, _ := cell(s.grid.xs, x)
+	dx := x - s.grid.xs[i]
+	var result float64
+	for k := 3; k >= 0; k-- {
+		result = result*dx + s.columns[i][k].Calc(y)
+	}
+	return result, nil
+}
+
+// Surface is the common interface of the interpolation methods of z(x, y).
+type Surface interface {
+	// Eval() calculates the approximate value of z(x, y).
+	Eval(x, y float64) (float64, error)
+	// Name() returns the short name of the method.
+	Name() string
+}
+
+type surfaceAdapter struct {
+	eval func(x, y float64) (float64, error)
+	name string
+}
+
+// BilinearAdapter() returns a Surface that evaluates the bilinear interpolation on the grid.
+func BilinearAdapter(grid *Grid) Surface {
+	return &surfaceAdapter{eval: grid.Bilinear, name: "Bilinear"}
+}
+
+// BicubicAdapter() returns a Surface that evaluates the bicubic convolution on the grid.
+func BicubicAdapter(grid *Grid) Surface {
+	return &surfaceAdapter{eval: grid.Bicubic, name: "Bicubic"}
+}
+
+// SplineSurfaceAdapter() returns a Surface that evaluates the tensor product spline.
+func SplineSurfaceAdapter(surface *SplineSurface) Surface {
+	return &surfaceAdapter{eval: surface.Calc, name: "Bicubic spline"}
+}
+
+func (a *surfaceAdapter) Eval(x, y float64) (float64, error) { return a.eval(x, y) }
+func (a *surfaceAdapter) Name() string                       { return a.name }
+
+// cell() returns the index i of the segment [nodes[i], nodes[i+1]] containing v and the position of v on it from 0 to 1.
+func cell(nodes []float64, v float64) (int, float64) {
+	i := sort.SearchFloat64s(nodes, v) - 1
+	i = max(0, min(i, len(nodes)-2))
+	return i, (v - nodes[i]) / (nodes[i+1] - nodes[i])
+}
+
+// convolution() interpolates between f[1] and f[2] by the cubic convolution kernel with a = -0.5, t from 0 to 1.
+func convolution(f [4]float64, t float64) float64 {
+	return f[1] + 0.5*t*(f[2]-f[0]+
+		t*(2*f[0]-5*f[1]+4*f[2]-f[3]+
+			t*(3*(f[1]-f[2])+f[3]-f[0])))
+}
+
+// uniform() reports whether the steps between the nodes are equal up to the rounding errors.
+func uniform(nodes []float64) bool {
+	step := (nodes[len(nodes)-1] - nodes[0]) / float64(len(nodes)-1)
+	for i := 1; i < len(nodes); i++ {
+		if math.Abs(nodes[i]-nodes[i-1]-step) > 1e-9*math.Abs(step) {
+			return false
+		}
+	}
+	return true
+}
+
+// increasing() reports whether the values are strictly increasing.
+func increasing(values []float64) bool {
+	for i := 1; i < len(values); i++ {
+		if !(values[i] > values[i-1]) || math.IsNaN(values[i]) {
+			return false
+		}
+	}
+	return true
+}
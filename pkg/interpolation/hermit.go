@@ -1,19 +1,13 @@
 package interpolation
 
 import (
-	"fmt"
 	"math"
 	"slices"
 	"sort"
-
-	"github.com/hahaclassic/computational-algorithms.git/internal/format"
 )
 
 type Hermit struct {
 	points         [][]float64
-	config         [][]float64
-	differences    [][]float64
-	numOfNodes     int
 	numDerivatives int // number of derivatives at a point
 }
 
@@ -42,11 +36,14 @@ func CreateHermitPolinomial(points [][]float64, numDerivatives int) (*Hermit, er
 }
 
 // SetPoints() modifies the set of points from which the approximate value is calculated.
+// It must not be called concurrently with the evaluation.
 // points[i][0] - x coordinate.
 // points[i][1] - y coordinate.
 // points[i][2] - the first derivative
 // points[i][3] - the second derivative
 func (h *Hermit) SetPoints(points [][]float64, numDerivatives int) error {
+	h.points = make([][]float64, len(points))
+	h.numDerivatives = numDerivatives
 	for i := 0; i < len(points); i++ {
 		if len(points[i]) < 2+numDerivatives {
 			return ErrNotEnoughInputData
@@ -61,6 +58,7 @@ func (h *Hermit) SetPoints(points [][]float64, numDerivatives int) error {
 }
 
 // SetNumDerivatives() modifies the num of derivatives.
+// It must not be called concurrently with the evaluation.
 func (h *Hermit) SetNumDerivatives(numOfDerivates int) error {
 	if len(h.points[0]) < 2+numOfDerivates {
 		return ErrInvalidNumDerivates
@@ -73,16 +71,33 @@ func (h *Hermit) SetNumDerivatives(numOfDerivates int) error {
 // x - the input value.
 // n - the degree of the Hermit polynomial.
 func (h *Hermit) Calc(x float64, n int) (float64, error) {
+	res, err := h.Evaluate(x, n)
+	if err != nil {
+		return UndefNum, err
+	}
+	return res.Value, nil
+}
+
+// Evaluate() calculates y(x) for the degree of the polynomial n and returns it together
+// with the nodes and the table of divided differences. The Hermit structure is not modified,
+// so one polynomial can be evaluated from several goroutines.
+// x - the input value.
+// n - the degree of the Hermit polynomial.
+func (h *Hermit) Evaluate(x float64, n int) (Result, error) {
 	if n < 0 {
-		return UndefNum, ErrInvalidPolynomialDegree
+		return Result{Value: UndefNum}, ErrInvalidPolynomialDegree
 	}
-	err := h.configure(x, n)
+	nodes, err := h.configure(x, n)
 	if err != nil {
-		return UndefNum, err
+		return Result{Value: UndefNum}, err
 	}
-	h.buildDiff()
+	differences := h.buildDiff(nodes)
 
-	return h.result(x), nil
+	return Result{
+		Value:       newtonResult(differences, x),
+		Nodes:       nodes,
+		Differences: differences,
+	}, nil
 }
 
 // FindRoot() finds root of the function (y == 0)
@@ -92,77 +107,83 @@ func (h *Hermit) FindRoot(n int) (float64, error) {
 		return UndefNum, ErrInvalidPolynomialDegree
 	}
 
-	source := h.points
 	inverted, err := Inverse(h.points)
 	if err != nil {
 		return UndefNum, err
 	}
-	h.points = inverted
-
-	x, err := h.Calc(0, n)
-	if err != nil {
-		return UndefNum, err
-	}
-	h.points = source
+	inverse := &Hermit{points: inverted, numDerivatives: h.numDerivatives}
 
-	return x, nil
+	return inverse.Calc(0, n)
 }
 
 // configure() creates a configuration of the values of the starting points. n + 1 points are selected, as close as possible to x.
 // x - the input value.
 // n - the degree of the Hermit polynomial.
-func (h *Hermit) configure(x float64, n int) error {
+func (h *Hermit) configure(x float64, n int) ([][]float64, error) {
 
 	if len(h.points) <= n {
-		return ErrNotEnoughInputData
+		return nil, ErrNotEnoughInputData
 	}
 
-	index, _ := slices.BinarySearchFunc(h.points, x, func(point []float64, pointX float64) int {
+	return h.fillConfig(h.window(x, n)), nil
+}
+
+// window() selects the points closest to x, every point is taken numDerivatives + 1 times,
+// the last selected one may be taken fewer times so that there are exactly n + 1 nodes.
+// n - degree of the polynomial
+func (h *Hermit) window(x float64, n int) window {
+	idx, _ := slices.BinarySearchFunc(h.points, x, func(point []float64, pointX float64) int {
 		if point[0] >= x {
 			return 1
 		}
 		return -1
 	})
 
-	h.fillConfig(x, n, index)
-
-	return nil
-}
-
-// n - degree of the polynomial
-func (h *Hermit) fillConfig(x float64, n int, idx int) {
-	h.numOfNodes = n + 1
+	numOfNodes := n + 1
 	left, right := idx-1, idx
-	leftNodes := [][]float64{}
-	rightNodes := [][]float64{}
+	w := window{}
 
-	for count := 0; count < h.numOfNodes; {
+	for count := 0; count < numOfNodes; {
 		var k int
-		if h.numOfNodes-count >= h.numDerivatives+1 {
+		if numOfNodes-count >= h.numDerivatives+1 {
 			k = h.numDerivatives + 1
 		} else {
-			k = h.numOfNodes - count
+			k = numOfNodes - count
 		}
 
 		if left >= 0 && right < len(h.points) &&
 			math.Abs(x-h.points[left][0]) < math.Abs(h.points[right][0]-x) {
-			addCopies(&leftNodes, h.points[left][:2+h.numDerivatives], k)
+			w.last = left
 			left--
 		} else if left >= 0 && right < len(h.points) {
-			addCopies(&rightNodes, h.points[right][:2+h.numDerivatives], k)
+			w.last = right
 			right++
 		} else if right < len(h.points) {
-			addCopies(&rightNodes, h.points[right][:2+h.numDerivatives], k)
+			w.last = right
 			right++
 		} else {
-			addCopies(&leftNodes, h.points[left][:2+h.numDerivatives], k)
+			w.last = left
 			left--
 		}
+		w.count = k
 		count += k
 	}
-	slices.Reverse(leftNodes)
-	h.config = leftNodes
-	h.config = append(h.config, rightNodes...)
+	w.start, w.end = left+1, right
+
+	return w
+}
+
+// fillConfig() returns the copies of the points of the window.
+func (h *Hermit) fillConfig(w window) [][]float64 {
+	nodes := [][]float64{}
+	for i := w.start; i < w.end; i++ {
+		k := h.numDerivatives + 1
+		if i == w.last {
+			k = w.count
+		}
+		addCopies(&nodes, h.points[i][:2+h.numDerivatives], k)
+	}
+	return nodes
 }
 
 func addCopies(dst *[][]float64, src []float64, n int) {
@@ -173,90 +194,45 @@ func addCopies(dst *[][]float64, src []float64, n int) {
 	}
 }
 
-// buildDiff() calculates the values of the divided differences.
-func (h *Hermit) buildDiff() {
+// buildDiff() calculates the table of divided differences for the nodes (multiple nodes use derivatives).
+func (h *Hermit) buildDiff(nodes [][]float64) [][]float64 {
 
-	h.differences = make([][]float64, h.numOfNodes)
-	for i := 0; i < h.numOfNodes; i++ {
-		h.differences[i] = h.config[i][:2]
+	numOfNodes := len(nodes) // Number of nodes in the current column of the table of differences.
+	n := numOfNodes - 1      // n - the degree of the Hermit polynomial.
+	factorial := 1           // From the formula of divided differences for multiple nodes
+
+	table := make([][]float64, numOfNodes)
+	for i := 0; i < numOfNodes; i++ {
+		table[i] = make([]float64, 2, 2+numOfNodes-i-1)
+		copy(table[i], nodes[i][:2])
 	}
-	numOfNodes := h.numOfNodes // Number of nodes in the current column of the table of differences.
-	n := h.numOfNodes - 1      // n - the degree of the Hermit polynomial.
-	factorial := 1             // From the formula of divided differences for multiple nodes
 
 	for k := 1; k <= n; k++ {
-		idx := len(h.differences[0]) - 1
+		idx := len(table[0]) - 1
 
 		for i := 0; i < numOfNodes-1; i++ {
 			var diff float64
-			if k <= h.numDerivatives && math.Abs(h.differences[i][0]-h.differences[i+k][0]) < delta {
-				fmt.Println(h.differences[i][0], h.config[i][k+1], factorial)
-				diff = h.config[i][k+1] / float64(factorial)
+			if k <= h.numDerivatives && math.Abs(table[i][0]-table[i+k][0]) < delta {
+				diff = nodes[i][k+1] / float64(factorial)
 			} else {
-				diff = (h.differences[i][idx] - h.differences[i+1][idx]) /
-					(h.differences[i][0] - h.differences[i+k][0])
+				diff = (table[i][idx] - table[i+1][idx]) /
+					(table[i][0] - table[i+k][0])
 			}
-			h.differences[i] = append(h.differences[i], diff)
+			table[i] = append(table[i], diff)
 		}
 		factorial *= (k + 1)
 		numOfNodes--
 	}
-}
-
-func (h *Hermit) result(x float64) float64 {
-	args := []float64{}
-	for i := 1; i <= h.numOfNodes; i++ {
-		args = append(args, h.differences[0][i])
-	}
-
-	var result, temp float64
-	for i := 0; i < len(args); i++ {
-		temp = args[i]
-		for j := 0; j < i; j++ {
-			temp *= (x - h.differences[j][0])
-		}
-		result += temp
-	}
 
-	return result
+	return table
 }
 
-// SepFiffTable() prints a table of the divided differences of the last operation.
-func (h *Hermit) PrintDiffTable() {
-
-	if len(h.differences) == 0 {
-		return
-	}
-
-	k := len(h.differences[0])*18 + 1
-	fmt.Println()
-	format.PrintLine(k)
-
-	fmt.Printf("|        x        |        y        ")
-	for i := 2; i < len(h.differences[0]); i++ {
-		fmt.Printf("|  y(x%-2d,..,x%-2d)  ", 0, i-1)
-	}
-	fmt.Println("|")
-
-	format.PrintLine(k)
-
-	for i := 0; i < len(h.differences); i++ {
-		for j := 0; j < len(h.differences[i]); j++ {
-			fmt.Printf("| ")
-			if h.differences[i][j] >= 0 {
-				fmt.Printf(" ")
-			}
-			fmt.Printf("%-14f ", h.differences[i][j])
-			if h.differences[i][j] < 0 {
-				fmt.Printf(" ")
-			}
-		}
-		for j := 0; j < len(h.differences[0])-len(h.differences[i]); j++ {
-			fmt.Printf("|                 ")
-		}
-		fmt.Println("|")
+// PrintDiffTable() prints a table of the divided differences for x and the degree of the polynomial n.
+func (h *Hermit) PrintDiffTable(x float64, n int) error {
+	res, err := h.Evaluate(x, n)
+	if err != nil {
+		return err
 	}
-
-	format.PrintLine(k)
-	fmt.Println()
+	res.PrintDiffTable()
+	return nil
 }
@@ -0,0 +1,359 @@
+package interpolation
+
+import (
+	"fmt"
+	"math"
+)
+
+// Interpolator is the common interface of all one-dimensional interpolation methods.
+// Code that compares, plots or exports results should be written against it.
+type Interpolator interface {
+	// Eval() calculates the approximate value of y(x).
+	Eval(x float64) (float64, error)
+	// Domain() returns the smallest and the largest x of the nodes.
+	Domain() (float64, float64)
+	// Nodes() returns a copy of the nodes used by the method.
+	// nodes[i][0] - x coordinate.
+	// nodes[i][1] - y coordinate.
+	Nodes() [][]float64
+	// Name() returns the short name of the method.
+	Name() string
+}
+
+// batchEvaluator is implemented by the interpolators that evaluate many points faster than one by one.
+type batchEvaluator interface {
+	EvalMany(x []float64) ([]float64, error)
+}
+
+type newtonAdapter struct {
+	newton *Newton
+	n      int
+}
+
+// NewtonAdapter() returns an Interpolator that evaluates the Newton polynomial of degree n.
+func NewtonAdapter(newton *Newton, n int) Interpolator {
+	return &newtonAdapter{newton: newton, n: n}
+}
+
+func (a *newtonAdapter) Eval(x float64) (float64, error) { return a.newton.Calc(x, a.n) }
+func (a *newtonAdapter) Domain() (float64, float64)      { return domain(a.newton.points) }
+func (a *newtonAdapter) Nodes() [][]float64              { return copyPoints(a.newton.points) }
+func (a *newtonAdapter) Name() string                    { return "Newton" }
+func (a *newtonAdapter) EvalMany(x []float64) ([]float64, error) {
+	return a.newton.EvaluateMany(x, a.n, 1)
+}
+
+type hermitAdapter struct {
+	hermit *Hermit
+	n      int
+}
+
+// HermitAdapter() returns an Interpolator that evaluates the Hermit polynomial of degree n.
+func HermitAdapter(hermit *Hermit, n int) Interpolator {
+	return &hermitAdapter{hermit: hermit, n: n}
+}
+
+func (a *hermitAdapter) Eval(x float64) (float64, error) { return a.hermit.Calc(x, a.n) }
+func (a *hermitAdapter) Domain() (float64, float64)      { return domain(a.hermit.points) }
+func (a *hermitAdapter) Nodes() [][]float64              { return copyPoints(a.hermit.points) }
+func (a *hermitAdapter) Name() string                    { return "Hermit" }
+func (a *hermitAdapter) EvalMany(x []float64) ([]float64, error) {
+	return a.hermit.EvaluateMany(x, a.n, 1)
+}
+
+type lagrangeAdapter struct {
+	lagrange *Lagrange
+	n        int
+}
+
+// LagrangeAdapter() returns an Interpolator that evaluates the Lagrange polynomial of degree n.
+func LagrangeAdapter(lagrange *Lagrange, n int) Interpolator {
+	return &lagrangeAdapter{lagrange: lagrange, n: n}
+}
+
+func (a *lagrangeAdapter) Eval(x float64) (float64, error) { return a.lagrange.Calc(x, a.n) }
+func (a *lagrangeAdapter) Domain() (float64, float64)      { return domain(a.lagrange.points) }
+func (a *lagrangeAdapter) Nodes() [][]float64              { return copyPoints(a.lagrange.points) }
+func (a *lagrangeAdapter) Name() string                    { return "Lagrange" }
+
+type barycentricAdapter struct {
+	barycentric *Barycentric
+	n           int
+}
+
+// BarycentricAdapter() returns an Interpolator that evaluates the barycentric interpolation of degree n.
+func BarycentricAdapter(barycentric *Barycentric, n int) Interpolator {
+	return &barycentricAdapter{barycentric: barycentric, n: n}
+}
+
+func (a *barycentricAdapter) Eval(x float64) (float64, error) { return a.barycentric.Calc(x, a.n) }
+func (a *barycentricAdapter) Domain() (float64, float64)      { return domain(a.barycentric.points) }
+func (a *barycentricAdapter) Nodes() [][]float64              { return copyPoints(a.barycentric.points) }
+func (a *barycentricAdapter) Name() string                    { return "Barycentric" }
+func (a *barycentricAdapter) EvalMany(x []float64) ([]float64, error) {
+	return a.barycentric.EvaluateMany(x, a.n, 1)
+}
+
+type nevilleAdapter struct {
+	neville *Neville
+	n       int
+}
+
+// NevilleAdapter() returns an Interpolator that evaluates the polynomial of degree n by the Neville-Aitken scheme.
+func NevilleAdapter(neville *Neville, n int) Interpolator {
+	return &nevilleAdapter{neville: neville, n: n}
+}
+
+func (a *nevilleAdapter) Eval(x float64) (float64, error) { return a.neville.Calc(x, a.n) }
+func (a *nevilleAdapter) Domain() (float64, float64)      { return domain(a.neville.points) }
+func (a *nevilleAdapter) Nodes() [][]float64              { return copyPoints(a.neville.points) }
+func (a *nevilleAdapter) Name() string                    { return "Neville" }
+
+type chebyshevAdapter struct {
+	chebyshev *Chebyshev
+}
+
+// ChebyshevAdapter() returns an Interpolator that evaluates the Chebyshev series.
+func ChebyshevAdapter(chebyshev *Chebyshev) Interpolator {
+	return &chebyshevAdapter{chebyshev: chebyshev}
+}
+
+func (a *chebyshevAdapter) Eval(x float64) (float64, error) { return a.chebyshev.Calc(x), nil }
+func (a *chebyshevAdapter) Domain() (float64, float64)      { return a.chebyshev.a, a.chebyshev.b }
+func (a *chebyshevAdapter) Nodes() [][]float64              { return copyPoints(a.chebyshev.points) }
+func (a *chebyshevAdapter) Name() string                    { return "Chebyshev" }
+
+type floaterHormannAdapter struct {
+	fh *FloaterHormann
+}
+
+// FloaterHormannAdapter() returns an Interpolator that evaluates the Floater-Hormann rational interpolation.
+func FloaterHormannAdapter(fh *FloaterHormann) Interpolator {
+	return &floaterHormannAdapter{fh: fh}
+}
+
+func (a *floaterHormannAdapter) Eval(x float64) (float64, error) { return a.fh.Calc(x), nil }
+func (a *floaterHormannAdapter) Domain() (float64, float64)      { return domain(a.fh.points) }
+func (a *floaterHormannAdapter) Nodes() [][]float64              { return copyPoints(a.fh.points) }
+func (a *floaterHormannAdapter) Name() string                    { return "Floater-Hormann" }
+
+type bulirschStoerAdapter struct {
+	bs *BulirschStoer
+	n  int
+}
+
+// BulirschStoerAdapter() returns an Interpolator that evaluates the Bulirsch-Stoer rational interpolation through n + 1 points.
+func BulirschStoerAdapter(bs *BulirschStoer, n int) Interpolator {
+	return &bulirschStoerAdapter{bs: bs, n: n}
+}
+
+func (a *bulirschStoerAdapter) Eval(x float64) (float64, error) { return a.bs.Calc(x, a.n) }
+func (a *bulirschStoerAdapter) Domain() (float64, float64)      { return domain(a.bs.points) }
+func (a *bulirschStoerAdapter) Nodes() [][]float64              { return copyPoints(a.bs.points) }
+func (a *bulirschStoerAdapter) Name() string                    { return "Bulirsch-Stoer" }
+
+type thieleAdapter struct {
+	thiele *Thiele
+	n      int
+}
+
+// ThieleAdapter() returns an Interpolator that evaluates the Thiele continued fraction through n + 1 points.
+func ThieleAdapter(thiele *Thiele, n int) Interpolator {
+	return &thieleAdapter{thiele: thiele, n: n}
+}
+
+func (a *thieleAdapter) Eval(x float64) (float64, error) { return a.thiele.Calc(x, a.n) }
+func (a *thieleAdapter) Domain() (float64, float64)      { return domain(a.thiele.points) }
+func (a *thieleAdapter) Nodes() [][]float64              { return copyPoints(a.thiele.points) }
+func (a *thieleAdapter) Name() string                    { return "Thiele" }
+
+type piecewiseAdapter struct {
+	piecewise *piecewise
+	name      string
+}
+
+// SplineAdapter() returns an Interpolator that evaluates the cubic spline.
+func SplineAdapter(spline *Spline) Interpolator {
+	return &piecewiseAdapter{piecewise: &spline.piecewise, name: "Spline"}
+}
+
+// AkimaAdapter() returns an Interpolator that evaluates the Akima spline.
+func AkimaAdapter(akima *Akima) Interpolator {
+	name := "Akima"
+	if akima.modified {
+		name = "Makima"
+	}
+	return &piecewiseAdapter{piecewise: &akima.piecewise, name: name}
+}
+
+// PchipAdapter() returns an Interpolator that evaluates the monotone piecewise cubic Hermite interpolation.
+func PchipAdapter(pchip *Pchip) Interpolator {
+	return &piecewiseAdapter{piecewise: &pchip.piecewise, name: "PCHIP"}
+}
+
+// SmoothingSplineAdapter() returns an Interpolator that evaluates the smoothing spline.
+func SmoothingSplineAdapter(spline *SmoothingSpline) Interpolator {
+	return &piecewiseAdapter{piecewise: &spline.piecewise, name: "Smoothing spline"}
+}
+
+// PiecewiseAdapter() returns an Interpolator that evaluates the piecewise polynomial function.
+func PiecewiseAdapter(pp *PiecewisePolynomial) Interpolator {
+	return &piecewiseAdapter{piecewise: &pp.piecewise, name: "Piecewise"}
+}
+
+func (a *piecewiseAdapter) Eval(x float64) (float64, error) { return a.piecewise.Calc(x), nil }
+func (a *piecewiseAdapter) Domain() (float64, float64)      { return domain(a.piecewise.points) }
+func (a *piecewiseAdapter) Nodes() [][]float64              { return copyPoints(a.piecewise.points) }
+func (a *piecewiseAdapter) Name() string                    { return a.name }
+func (a *piecewiseAdapter) EvalMany(x []float64) ([]float64, error) {
+	return a.piecewise.EvaluateMany(x, 1), nil
+}
+
+type bsplineAdapter struct {
+	bspline *BSpline
+}
+
+// BSplineAdapter() returns an Interpolator that evaluates the B-spline.
+func BSplineAdapter(bspline *BSpline) Interpolator {
+	return &bsplineAdapter{bspline: bspline}
+}
+
+func (a *bsplineAdapter) Eval(x float64) (float64, error) { return a.bspline.Calc(x), nil }
+func (a *bsplineAdapter) Domain() (float64, float64) {
+	return a.bspline.knots[a.bspline.degree], a.bspline.knots[len(a.bspline.coefs)]
+}
+func (a *bsplineAdapter) Nodes() [][]float64 { return a.bspline.nodes() }
+func (a *bsplineAdapter) Name() string       { return fmt.Sprintf("B-spline (p=%d)", a.bspline.degree) }
+
+// EvalAll() calculates the values of the interpolator at every x.
+func EvalAll(ip Interpolator, x []float64) ([]float64, error) {
+	if batch, ok := ip.(batchEvaluator); ok {
+		return batch.EvalMany(x)
+	}
+
+	result := make([]float64, len(x))
+	for i := 0; i < len(x); i++ {
+		y, err := ip.Eval(x[i])
+		if err != nil {
+			return nil, err
+		}
+		result[i] = y
+	}
+	return result, nil
+}
+
+// Sample() calculates num equally spaced values of the interpolator on its domain (for plotting and export).
+func Sample(ip Interpolator, num int) ([]float64, []float64, error) {
+	if num < 2 {
+		return nil, nil, ErrNotEnoughInputData
+	}
+	start, end := ip.Domain()
+	step := (end - start) / float64(num-1)
+
+	x := make([]float64, num)
+	for i := 0; i < num; i++ {
+		x[i] = start + float64(i)*step
+	}
+	y, err := EvalAll(ip, x)
+	if err != nil {
+		return nil, nil, err
+	}
+	return x, y, nil
+}
+
+// Derivative() returns the first derivative of the interpolator at x (central difference).
+func Derivative(ip Interpolator, x float64) (float64, error) {
+	y1, err := ip.Eval(x - dx)
+	if err != nil {
+		return UndefNum, err
+	}
+	y2, err := ip.Eval(x + dx)
+	if err != nil {
+		return UndefNum, err
+	}
+	return (y2 - y1) / (2 * dx), nil
+}
+
+// Derivative2() returns the second derivative of the interpolator at x (central difference).
+func Derivative2(ip Interpolator, x float64) (float64, error) {
+	y1, err := ip.Eval(x - dx)
+	if err != nil {
+		return UndefNum, err
+	}
+	y2, err := ip.Eval(x + dx)
+	if err != nil {
+		return UndefNum, err
+	}
+	y0, err := ip.Eval(x)
+	if err != nil {
+		return UndefNum, err
+	}
+	return (y2 + y1 - 2*y0) / (dx * dx), nil
+}
+
+// FindRootOf() finds the first root of the interpolator (y == 0) on its domain.
+// The interval between two neighbouring nodes with a sign change is refined by bisection.
+func FindRootOf(ip Interpolator) (float64, error) {
+	nodes := ip.Nodes()
+	if len(nodes) == 0 {
+		return UndefNum, ErrNotEnoughInputData
+	}
+
+	left := nodes[0][0]
+	yLeft, err := ip.Eval(left)
+	if err != nil {
+		return UndefNum, err
+	}
+	if math.Abs(yLeft) < delta {
+		return left, nil
+	}
+
+	for i := 1; i < len(nodes); i++ {
+		right := nodes[i][0]
+		yRight, err := ip.Eval(right)
+		if err != nil {
+			return UndefNum, err
+		}
+		if math.Abs(yRight) < delta {
+			return right, nil
+		}
+		if yLeft*yRight < 0 {
+			return bisection(ip, left, right, yLeft)
+		}
+		left, yLeft = right, yRight
+	}
+
+	return UndefNum, ErrNoRoot
+}
+
+func bisection(ip Interpolator, left, right, yLeft float64) (float64, error) {
+	for right-left > delta {
+		mid := (left + right) / 2
+		yMid, err := ip.Eval(mid)
+		if err != nil {
+			return UndefNum, err
+		}
+		if yLeft*yMid <= 0 {
+			right = mid
+		} else {
+			left, yLeft = mid, yMid
+		}
+	}
+	return (left + right) / 2, nil
+}
+
+func domain(points [][]float64) (float64, float64) {
+	if len(points) == 0 {
+		return UndefNum, UndefNum
+	}
+	return points[0][0], points[len(points)-1][0]
+}
+
+func copyPoints(points [][]float64) [][]float64 {
+	result := make([][]float64, len(points))
+	for i := 0; i < len(points); i++ {
+		result[i] = make([]float64, len(points[i]))
+		copy(result[i], points[i])
+	}
+	return result
+}
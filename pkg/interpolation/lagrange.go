@@ -0,0 +1,45 @@
+package interpolation
+
+type Lagrange struct {
+	points [][]float64
+}
+
+// CreateLagrangePolinomial() creates a Lagrange structure that implements interpolation using the Lagrange polynomial.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateLagrangePolinomial(points [][]float64) (*Lagrange, error) {
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+	return &Lagrange{points: sorted}, nil
+}
+
+// Calc() calculates the approximate value of y(x) for the degree of the polynomial n.
+// n + 1 points, as close as possible to x, are used (the same as for the Newton polynomial).
+// x - the input value.
+// n - the degree of the Lagrange polynomial.
+func (l *Lagrange) Calc(x float64, n int) (float64, error) {
+	if n < 0 {
+		return UndefNum, ErrInvalidPolynomialDegree
+	}
+	if len(l.points) <= n {
+		return UndefNum, ErrNotEnoughInputData
+	}
+
+	start, end := nearestNodes(l.points, x, n)
+	nodes := l.points[start:end]
+
+	var result float64
+	for i := 0; i < len(nodes); i++ {
+		basis := 1.0
+		for j := 0; j < len(nodes); j++ {
+			if i != j {
+				basis *= (x - nodes[j][0]) / (nodes[i][0] - nodes[j][0])
+			}
+		}
+		result += nodes[i][1] * basis
+	}
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package interpolation
+
+import "math"
+
+type Neville struct {
+	points [][]float64
+}
+
+// NevilleResult is the outcome of the evaluation by the Neville-Aitken scheme.
+// Error - the estimate of the error |P(x0,..,xn) - P(x0,..,xn-1)| (UndefNum for n == 0).
+// Tableau[i] - x, y and the values P(xi,..,xi+k) of the polynomials through the nodes xi,..,xi+k.
+type NevilleResult struct {
+	Value   float64
+	Error   float64
+	Nodes   [][]float64
+	Tableau [][]float64
+}
+
+// CreateNeville() creates a Neville structure that implements interpolation by the Neville-Aitken scheme.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateNeville(points [][]float64) (*Neville, error) {
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+	return &Neville{points: sorted}, nil
+}
+
+// Calc() calculates the approximate value of y(x) for the degree of the polynomial n.
+// x - the input value.
+// n - the degree of the polynomial.
+func (nv *Neville) Calc(x float64, n int) (float64, error) {
+	res, err := nv.Evaluate(x, n)
+	if err != nil {
+		return UndefNum, err
+	}
+	return res.Value, nil
+}
+
+// Evaluate() calculates y(x) for the degree of the polynomial n together with the error estimate and the tableau.
+// n + 1 points, as close as possible to x, are used (the same as for the Newton polynomial).
+// x - the input value.
+// n - the degree of the polynomial.
+func (nv *Neville) Evaluate(x float64, n int) (NevilleResult, error) {
+	if n < 0 {
+		return NevilleResult{Value: UndefNum, Error: UndefNum}, ErrInvalidPolynomialDegree
+	}
+	if len(nv.points) <= n {
+		return NevilleResult{Value: UndefNum, Error: UndefNum}, ErrNotEnoughInputData
+	}
+
+	start, end := nearestNodes(nv.points, x, n)
+	nodes := copyPoints(nv.points[start:end])
+	tableau := buildTableau(nodes, x)
+
+	res := NevilleResult{
+		Value:   tableau[0][n+1],
+		Error:   UndefNum,
+		Nodes:   nodes,
+		Tableau: tableau,
+	}
+	if n > 0 {
+		res.Error = math.Abs(tableau[0][n+1] - tableau[0][n])
+	}
+
+	return res, nil
+}
+
+// buildTableau() calculates P(xi,..,xi+k) = ((x - xi+k) * P(xi,..,xi+k-1) - (x - xi) * P(xi+1,..,xi+k)) / (xi - xi+k).
+func buildTableau(nodes [][]float64, x float64) [][]float64 {
+	numOfNodes := len(nodes)
+
+	tableau := make([][]float64, numOfNodes)
+	for i := 0; i < numOfNodes; i++ {
+		tableau[i] = make([]float64, 2, 2+numOfNodes-i-1)
+		copy(tableau[i], nodes[i][:2])
+	}
+
+	for k := 1; k < len(nodes); k++ {
+		for i := 0; i < numOfNodes-1; i++ {
+			p := ((x-tableau[i+k][0])*tableau[i][k] - (x-tableau[i][0])*tableau[i+1][k]) /
+				(tableau[i][0] - tableau[i+k][0])
+			tableau[i] = append(tableau[i], p)
+		}
+		numOfNodes--
+	}
+
+	return tableau
+}
+
+// PrintTableau() prints the Neville tableau of the evaluation.
+func (r NevilleResult) PrintTableau() {
+	printDiffTable(r.Tableau, "P")
+}
+
+// PrintTableau() prints the Neville tableau for x and the degree of the polynomial n.
+func (nv *Neville) PrintTableau(x float64, n int) error {
+	res, err := nv.Evaluate(x, n)
+	if err != nil {
+		return err
+	}
+	res.PrintTableau()
+	return nil
+}
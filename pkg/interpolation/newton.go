@@ -0,0 +1,192 @@
+package interpolation
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+type Newton struct {
+	points [][]float64
+}
+
+// CreateNewtonPolinomial() creates a Newton structure that implements interpolation using the Newton polynomial.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateNewtonPolinomial(points [][]float64) (*Newton, error) {
+	newton := &Newton{}
+
+	err := newton.SetPoints(points)
+	if err != nil {
+		return nil, err
+	}
+
+	return newton, nil
+}
+
+// SetPoints() modifies the set of points from which the approximate value is calculated.
+// It must not be called concurrently with the evaluation.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func (newton *Newton) SetPoints(points [][]float64) error {
+	newton.points = make([][]float64, len(points))
+	for i := 0; i < len(points); i++ {
+		if len(points[i]) < 2 {
+			return ErrNotEnoughInputData
+		}
+		newton.points[i] = make([]float64, 2)
+		copy(newton.points[i], points[i][:2])
+	}
+	sort.SliceStable(newton.points, func(i, j int) bool {
+		return newton.points[i][0] < newton.points[j][0]
+	})
+
+	return nil
+}
+
+// Derivative() returns first derivative of a polynomial
+func (newton *Newton) Derivative(x float64, n int) (float64, error) {
+	return Derivative(NewtonAdapter(newton, n), x)
+}
+
+// Derivative2() returns second derivative of a polynomial
+func (newton *Newton) Derivative2(x float64, n int) (float64, error) {
+	return Derivative2(NewtonAdapter(newton, n), x)
+}
+
+// Calc() calculates the approximate value of y(x) for the degree of the polynomial n.
+// x - the input value.
+// n - the degree of the Newton polynomial.
+func (newton *Newton) Calc(x float64, n int) (float64, error) {
+	res, err := newton.Evaluate(x, n)
+	if err != nil {
+		return UndefNum, err
+	}
+	return res.Value, nil
+}
+
+// Evaluate() calculates y(x) for the degree of the polynomial n and returns it together
+// with the nodes and the table of divided differences. The Newton structure is not modified,
+// so one polynomial can be evaluated from several goroutines.
+// x - the input value.
+// n - the degree of the Newton polynomial.
+func (newton *Newton) Evaluate(x float64, n int) (Result, error) {
+	if n < 0 {
+		return Result{Value: UndefNum}, ErrInvalidPolynomialDegree
+	}
+	nodes, err := newton.configure(x, n)
+	if err != nil {
+		return Result{Value: UndefNum}, err
+	}
+	differences := buildNewtonDiff(nodes)
+
+	return Result{
+		Value:       newtonResult(differences, x),
+		Nodes:       nodes,
+		Differences: differences,
+	}, nil
+}
+
+// FindRoot() finds root of the function (y == 0)
+// n - the degree of the Newton polynomial.
+func (newton *Newton) FindRoot(n int) (float64, error) {
+	if n < 0 {
+		return UndefNum, ErrInvalidPolynomialDegree
+	}
+
+	inverted, err := Inverse(newton.points)
+	if err != nil {
+		return UndefNum, err
+	}
+	inverse := &Newton{points: inverted}
+
+	return inverse.Calc(0, n)
+}
+
+// configure() returns a copy of n + 1 points, as close as possible to x.
+// x - the input value.
+// n - the degree of the Newton polynomial.
+func (newton *Newton) configure(x float64, n int) ([][]float64, error) {
+
+	if len(newton.points) <= n {
+		return nil, ErrNotEnoughInputData
+	}
+
+	start, end := nearestNodes(newton.points, x, n)
+
+	return copyPoints(newton.points[start:end]), nil
+}
+
+// nearestNodes() selects n + 1 neighbouring points, as close as possible to x.
+// Returns the indices of the first and the next after the last selected point.
+// points must be sorted by x and contain at least n + 1 points.
+func nearestNodes(points [][]float64, x float64, n int) (int, int) {
+	idx, _ := slices.BinarySearchFunc(points, x, func(point []float64, pointX float64) int {
+		if point[0] >= x {
+			return 1
+		}
+		return -1
+	})
+	left, right := idx-1, idx
+
+	for count := 0; count < n+1; count++ {
+		if left >= 0 && right < len(points) &&
+			math.Abs(x-points[left][0]) < math.Abs(points[right][0]-x) {
+			left--
+		} else if left >= 0 && right < len(points) {
+			right++
+		} else if right < len(points) {
+			right++
+		} else {
+			left--
+		}
+	}
+
+	return left + 1, right
+}
+
+// buildNewtonDiff() calculates the table of divided differences.
+// table[i] - x, y and the divided differences y(xi,..,xi+k).
+func buildNewtonDiff(nodes [][]float64) [][]float64 {
+
+	numOfNodes := len(nodes)
+	n := numOfNodes - 1 // n - the degree of the Newton polynomial.
+
+	table := make([][]float64, numOfNodes)
+	for i := 0; i < numOfNodes; i++ {
+		table[i] = make([]float64, 2, 2+numOfNodes-i-1)
+		copy(table[i], nodes[i][:2])
+	}
+
+	for k := 1; k <= n; k++ {
+		idx := len(table[0]) - 1
+		for i := 0; i < numOfNodes-1; i++ {
+			diff := (table[i][idx] - table[i+1][idx]) / (table[i][0] - table[i+k][0])
+			table[i] = append(table[i], diff)
+		}
+		numOfNodes--
+	}
+
+	return table
+}
+
+func newtonResult(table [][]float64, x float64) float64 {
+	var result float64
+	var product float64 = 1
+	for i := 1; i < len(table[0]); i++ {
+		result += table[0][i] * product
+		product *= (x - table[i-1][0])
+	}
+
+	return result
+}
+
+// PrintDiffTable() prints a table of the divided differences for x and the degree of the polynomial n.
+func (newton *Newton) PrintDiffTable(x float64, n int) error {
+	res, err := newton.Evaluate(x, n)
+	if err != nil {
+		return err
+	}
+	res.PrintDiffTable()
+	return nil
+}
@@ -0,0 +1,111 @@
+package interpolation
+
+import "slices"
+
+// Table3D is the table of the function u(x, y, z).
+// u[k][j][i] - the value at (xs[i], ys[j], zs[k]), xs, ys and zs are strictly increasing.
+// A Table3D is never modified after creation, so it can be evaluated from several goroutines.
+type Table3D struct {
+	xs []float64
+	ys []float64
+	zs []float64
+	u  [][][]float64
+}
+
+// CreateTable3D() creates the table by the nodes and the values, the slices are copied.
+// u[k][j][i] - the value at (xs[i], ys[j], zs[k]).
+func CreateTable3D(xs, ys, zs []float64, u [][][]float64) (*Table3D, error) {
+	if len(zs) < 1 || len(u) != len(zs) {
+		return nil, ErrNotEnoughInputData
+	}
+	if !increasing(zs) {
+		return nil, ErrInvalidGrid
+	}
+
+	table := &Table3D{zs: append([]float64{}, zs...), u: make([][][]float64, len(zs))}
+	for k := 0; k < len(zs); k++ {
+		grid, err := CreateGrid(xs, ys, u[k])
+		if err != nil {
+			return nil, err
+		}
+		table.xs, table.ys, table.u[k] = grid.xs, grid.ys, grid.z
+	}
+	return table, nil
+}
+
+// CreateTable3DFromSlices() creates the table by the slices z = zs[k], tables[k] - the slice in the layout of CreateGridFromTable(),
+// all the slices must have the same nodes x and y.
+func CreateTable3DFromSlices(zs []float64, tables [][][]float64) (*Table3D, error) {
+	if len(tables) != len(zs) || len(zs) < 1 {
+		return nil, ErrNotEnoughInputData
+	}
+
+	u := make([][][]float64, len(zs))
+	var first *Grid
+	for k := 0; k < len(tables); k++ {
+		grid, err := CreateGridFromTable(tables[k])
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = grid
+		} else if !slices.Equal(first.xs, grid.xs) || !slices.Equal(first.ys, grid.ys) {
+			return nil, ErrInvalidGrid
+		}
+		u[k] = grid.z
+	}
+	return CreateTable3D(first.xs, first.ys, zs, u)
+}
+
+// Domain() returns the bounds of the table: xmin, xmax, ymin, ymax, zmin, zmax.
+func (t *Table3D) Domain() (float64, float64, float64, float64, float64, float64) {
+	return t.xs[0], t.xs[len(t.xs)-1], t.ys[0], t.ys[len(t.ys)-1], t.zs[0], t.zs[len(t.zs)-1]
+}
+
+// Newton() calculates u(x, y, z) by the nested Newton interpolation: the polynomials of degree nx along x
+// on the rows of the table, then of degree ny along y through their values and of degree nz along z.
+// The nodes along every axis are selected as close as possible to the point, the same way as by Newton.
+func (t *Table3D) Newton(x, y, z float64, nx, ny, nz int) (float64, error) {
+	if nx < 0 || ny < 0 || nz < 0 {
+		return UndefNum, ErrInvalidPolynomialDegree
+	}
+	if nx >= len(t.xs) || ny >= len(t.ys) || nz >= len(t.zs) {
+		return UndefNum, ErrNotEnoughInputData
+	}
+
+	yStart, yEnd := nearestNodes(axisPoints(t.ys), y, ny)
+	zStart, zEnd := nearestNodes(axisPoints(t.zs), z, nz)
+
+	zValues := make([][]float64, 0, nz+1)
+	for k := zStart; k < zEnd; k++ {
+		yValues := make([][]float64, 0, ny+1)
+		for j := yStart; j < yEnd; j++ {
+			row := make([][]float64, len(t.xs))
+			for i := 0; i < len(t.xs); i++ {
+				row[i] = []float64{t.xs[i], t.u[k][j][i]}
+			}
+			value, err := (&Newton{points: row}).Calc(x, nx)
+			if err != nil {
+				return UndefNum, err
+			}
+			yValues = append(yValues, []float64{t.ys[j], value})
+		}
+
+		value, err := (&Newton{points: yValues}).Calc(y, ny)
+		if err != nil {
+			return UndefNum, err
+		}
+		zValues = append(zValues, []float64{t.zs[k], value})
+	}
+
+	return (&Newton{points: zValues}).Calc(z, nz)
+}
+
+// axisPoints() returns the nodes of an axis in the layout of the points of Newton.
+func axisPoints(nodes []float64) [][]float64 {
+	points := make([][]float64, len(nodes))
+	for i := 0; i < len(nodes); i++ {
+		points[i] = []float64{nodes[i]}
+	}
+	return points
+}
@@ -0,0 +1,75 @@
+package interpolation
+
+import "math"
+
+// Pchip is the monotone piecewise cubic Hermite interpolation (PCHIP).
+// The derivatives satisfy the Fritsch-Carlson conditions, so the interpolant is monotone
+// on every segment where the data are monotone and has no extrema except at the nodes.
+type Pchip struct {
+	piecewise
+}
+
+// CreatePchip() creates the monotone piecewise cubic Hermite interpolation.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreatePchip(points [][]float64) (*Pchip, error) {
+	if len(points) < 2 {
+		return nil, ErrNotEnoughInputData
+	}
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	derivatives := pchipDerivatives(sorted, segmentSlopes(sorted))
+
+	return &Pchip{piecewise{
+		points: sorted,
+		config: hermiteSegments(sorted, derivatives),
+	}}, nil
+}
+
+// pchipDerivatives() calculates the derivatives at the points.
+// Inner points: 0 at a local extremum of the data, otherwise the weighted harmonic mean
+// of the slopes (w1 + w2) / (w1 / mi-1 + w2 / mi), w1 = 2hi + hi-1, w2 = hi + 2hi-1.
+// End points: the three-point formula limited to keep the shape.
+func pchipDerivatives(points [][]float64, slopes []float64) []float64 {
+	n := len(slopes)
+	derivatives := make([]float64, n+1)
+	if n == 1 {
+		derivatives[0], derivatives[1] = slopes[0], slopes[0]
+		return derivatives
+	}
+
+	h := make([]float64, n)
+	for i := 0; i < n; i++ {
+		h[i] = points[i+1][0] - points[i][0]
+	}
+
+	for i := 1; i < n; i++ {
+		if slopes[i-1]*slopes[i] <= 0 {
+			continue
+		}
+		w1 := 2*h[i] + h[i-1]
+		w2 := h[i] + 2*h[i-1]
+		derivatives[i] = (w1 + w2) / (w1/slopes[i-1] + w2/slopes[i])
+	}
+
+	derivatives[0] = pchipEndDerivative(h[0], h[1], slopes[0], slopes[1])
+	derivatives[n] = pchipEndDerivative(h[n-1], h[n-2], slopes[n-1], slopes[n-2])
+
+	return derivatives
+}
+
+// pchipEndDerivative() calculates the derivative at the end point by the three-point formula,
+// h0, m0 - the outer segment, h1, m1 - the neighbouring one.
+func pchipEndDerivative(h0, h1, m0, m1 float64) float64 {
+	d := ((2*h0+h1)*m0 - h0*m1) / (h0 + h1)
+	if math.Signbit(d) != math.Signbit(m0) || m0 == 0 {
+		return 0
+	}
+	if math.Signbit(m0) != math.Signbit(m1) && math.Abs(d) > 3*math.Abs(m0) {
+		return 3 * m0
+	}
+	return d
+}
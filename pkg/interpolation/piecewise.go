@@ -0,0 +1,146 @@
+package interpolation
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/format"
+)
+
+// piecewise is a piecewise polynomial function.
+// config[i] - the coefficients a, b, c, d, ... of the polynomial
+// a + b(x - xi-1) + c(x - xi-1)^2 + d(x - xi-1)^3 + ... on the segment [xi-1, xi], config[0] is not used.
+type piecewise struct {
+	points [][]float64
+	config [][]float64
+}
+
+// Calc() calculates the approximate value of y(x).
+func (p *piecewise) Calc(x float64) float64 {
+	return p.value(p.lowerBound(x), x)
+}
+
+// lowerBound() returns the index of the first point with point[0] >= x.
+func (p *piecewise) lowerBound(x float64) int {
+	index, _ := slices.BinarySearchFunc(p.points, x, func(point []float64, pointX float64) int {
+		if point[0] >= x {
+			return 1
+		}
+		return -1
+	})
+	return index
+}
+
+// value() calculates y(x) on the segment [index - 1, index] (the outer segments are used outside the table).
+func (p *piecewise) value(index int, x float64) float64 {
+	if index == 0 {
+		index++
+	} else if index == len(p.points) {
+		index--
+	}
+
+	var result float64
+	coefs := p.config[index]
+	for i := len(coefs) - 1; i >= 0; i-- {
+		result = result*(x-p.points[index-1][0]) + coefs[i]
+	}
+
+	return result
+}
+
+// derivative() calculates the derivative of the given order at x.
+func (p *piecewise) derivative(x float64, order int) float64 {
+	index := max(1, min(p.lowerBound(x), len(p.points)-1))
+
+	var result float64
+	coefs := p.config[index]
+	for i := len(coefs) - 1; i >= order; i-- {
+		factor := 1.0
+		for k := i - order + 1; k <= i; k++ {
+			factor *= float64(k)
+		}
+		result = result*(x-p.points[index-1][0]) + factor*coefs[i]
+	}
+
+	return result
+}
+
+// EvaluateMany() calculates the approximate values of y(x) for every x.
+// Sorted runs of xs are processed in a single pass over the segments.
+// workers - the maximum number of goroutines processing the parts of xs (workers <= 1 - the calling goroutine only).
+func (p *piecewise) EvaluateMany(xs []float64, workers int) []float64 {
+	if workers < 1 {
+		workers = 1
+	}
+	size := (len(xs) + workers - 1) / workers
+
+	result := make([]float64, len(xs))
+	runPool(workers, workers, func(i int) {
+		start, end := min(i*size, len(xs)), min((i+1)*size, len(xs))
+		p.evaluatePart(xs[start:end], result[start:end])
+	})
+
+	return result
+}
+
+// evaluatePart() writes the values of the function for xs into result.
+func (p *piecewise) evaluatePart(xs []float64, result []float64) {
+	index := 0
+	for i := 0; i < len(xs); i++ {
+		if i > 0 && xs[i] >= xs[i-1] {
+			for index < len(p.points) && p.points[index][0] < xs[i] {
+				index++
+			}
+		} else {
+			index = p.lowerBound(xs[i])
+		}
+		result[i] = p.value(index, xs[i])
+	}
+}
+
+// PrintCoefficients() prints the coefficients of the polynomials on every segment.
+func (p *piecewise) PrintCoefficients() {
+	size := 0
+	for i := 1; i < len(p.config); i++ {
+		size = max(size, len(p.config[i]))
+	}
+
+	k := (size+2)*17 + 1
+	fmt.Println()
+	format.PrintLine(k)
+	fmt.Print("|      x i-1     |       x i      ")
+	for j := 0; j < size; j++ {
+		fmt.Printf("|        %c       ", 'a'+j)
+	}
+	fmt.Println("|")
+	format.PrintLine(k)
+	for i := 1; i < len(p.config); i++ {
+		fmt.Printf("| %-14f | %-14f ", p.points[i-1][0], p.points[i][0])
+		for j := 0; j < len(p.config[i]); j++ {
+			fmt.Printf("| %-14f ", p.config[i][j])
+		}
+		for j := len(p.config[i]); j < size; j++ {
+			fmt.Printf("|                ")
+		}
+		fmt.Println("|")
+	}
+	format.PrintLine(k)
+	fmt.Println()
+}
+
+// hermiteSegments() calculates the coefficients of the piecewise cubic Hermite polynomial by the values
+// and the first derivatives at the points.
+func hermiteSegments(points [][]float64, derivatives []float64) [][]float64 {
+	config := make([][]float64, len(points))
+	for i := 1; i < len(points); i++ {
+		h := points[i][0] - points[i-1][0]
+		slope := (points[i][1] - points[i-1][1]) / h
+		config[i] = []float64{
+			points[i-1][1],
+			derivatives[i-1],
+			(3*slope - 2*derivatives[i-1] - derivatives[i]) / h,
+			(derivatives[i-1] + derivatives[i] - 2*slope) / (h * h),
+		}
+	}
+	return config
+}
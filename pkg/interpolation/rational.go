@@ -0,0 +1,145 @@
+package interpolation
+
+import "math"
+
+const tiny float64 = 1e-25 // prevents division by zero in the Bulirsch-Stoer scheme, when y == 0
+
+// FloaterHormann implements the Floater-Hormann barycentric rational interpolation.
+// The interpolant blends all polynomials of degree d through d + 1 consecutive points,
+// it has no real poles and converges as O(h^(d+1)).
+type FloaterHormann struct {
+	points  [][]float64
+	d       int
+	weights []float64
+}
+
+// CreateFloaterHormann() creates a FloaterHormann structure with the blending degree d.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateFloaterHormann(points [][]float64, d int) (*FloaterHormann, error) {
+	if d < 0 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	if len(points) <= d {
+		return nil, ErrNotEnoughInputData
+	}
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+	return &FloaterHormann{
+		points:  sorted,
+		d:       d,
+		weights: floaterHormannWeights(sorted, d),
+	}, nil
+}
+
+// floaterHormannWeights() calculates wk = (-1)^(k-d) * Σ П 1 / |xk - xj|,
+// the sum is over i from max(0, k - d) to min(k, n - d), the product is over j from i to i + d, j != k.
+func floaterHormannWeights(points [][]float64, d int) []float64 {
+	n := len(points) - 1
+	weights := make([]float64, len(points))
+	for k := 0; k <= n; k++ {
+		var sum float64
+		for i := max(0, k-d); i <= min(k, n-d); i++ {
+			product := 1.0
+			for j := i; j <= i+d; j++ {
+				if j != k {
+					product /= math.Abs(points[k][0] - points[j][0])
+				}
+			}
+			sum += product
+		}
+		if (k-d)%2 != 0 {
+			sum = -sum
+		}
+		weights[k] = sum
+	}
+	return weights
+}
+
+// Calc() calculates the approximate value of y(x).
+func (fh *FloaterHormann) Calc(x float64) float64 {
+	return barycentricResult(fh.points, fh.weights, x)
+}
+
+// BulirschStoer implements the diagonal rational interpolation by the Bulirsch-Stoer scheme.
+// It can be used for the extrapolation outside the table.
+type BulirschStoer struct {
+	points [][]float64
+}
+
+// CreateBulirschStoer() creates a BulirschStoer structure.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateBulirschStoer(points [][]float64) (*BulirschStoer, error) {
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+	return &BulirschStoer{points: sorted}, nil
+}
+
+// Calc() calculates the approximate value of y(x) by the rational function through n + 1 points, as close as possible to x.
+// x - the input value.
+// n - the number of points minus one (the sum of the degrees of the numerator and the denominator).
+func (bs *BulirschStoer) Calc(x float64, n int) (float64, error) {
+	y, _, err := bs.Evaluate(x, n)
+	return y, err
+}
+
+// Evaluate() calculates the approximate value of y(x) and the estimate of its error (the last correction).
+// x - the input value.
+// n - the number of points minus one (the sum of the degrees of the numerator and the denominator).
+func (bs *BulirschStoer) Evaluate(x float64, n int) (float64, float64, error) {
+	if n < 0 {
+		return UndefNum, UndefNum, ErrInvalidPolynomialDegree
+	}
+	if len(bs.points) <= n {
+		return UndefNum, UndefNum, ErrNotEnoughInputData
+	}
+	start, end := nearestNodes(bs.points, x, n)
+	nodes := bs.points[start:end]
+
+	num := len(nodes)
+	c, d := make([]float64, num), make([]float64, num)
+	nearest := 0
+	for i := 0; i < num; i++ {
+		h := math.Abs(x - nodes[i][0])
+		if h == 0 {
+			return nodes[i][1], 0, nil
+		}
+		if h < math.Abs(x-nodes[nearest][0]) {
+			nearest = i
+		}
+		c[i] = nodes[i][1]
+		d[i] = nodes[i][1] + tiny
+	}
+
+	y := nodes[nearest][1]
+	nearest--
+	var dy float64
+	for m := 1; m < num; m++ {
+		for i := 0; i < num-m; i++ {
+			w := c[i+1] - d[i]
+			h := nodes[i+m][0] - x
+			t := (nodes[i][0] - x) * d[i] / h
+			dd := t - c[i+1]
+			if dd == 0 {
+				return UndefNum, UndefNum, ErrPole
+			}
+			dd = w / dd
+			d[i] = c[i+1] * dd
+			c[i] = t * dd
+		}
+		if 2*(nearest+1) < num-m {
+			dy = c[nearest+1]
+		} else {
+			dy = d[nearest]
+			nearest--
+		}
+		y += dy
+	}
+
+	return y, math.Abs(dy), nil
+}
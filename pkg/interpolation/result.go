@@ -0,0 +1,65 @@
+package interpolation
+
+import (
+	"fmt"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/format"
+)
+
+// Result is the outcome of a single evaluation of a polynomial.
+// Nodes[i] - the node used for the evaluation (x, y and the derivatives, if any).
+// Differences[i] - x, y and the divided differences y(xi,..,xi+k).
+type Result struct {
+	Value       float64
+	Nodes       [][]float64
+	Differences [][]float64
+	symbol      string // the name of the differences in the header of the table, "y" if empty
+}
+
+// PrintDiffTable() prints the table of the differences of the evaluation.
+func (r Result) PrintDiffTable() {
+	symbol := r.symbol
+	if symbol == "" {
+		symbol = "y"
+	}
+	printDiffTable(r.Differences, symbol)
+}
+
+// printDiffTable() prints a triangular table, name is the name of the values in the header (y(x0,..,xk)).
+func printDiffTable(table [][]float64, name string) {
+	if len(table) == 0 {
+		return
+	}
+
+	k := len(table[0])*18 + 1
+	fmt.Println()
+	format.PrintLine(k)
+
+	fmt.Printf("|        x        |        y        ")
+	for i := 2; i < len(table[0]); i++ {
+		fmt.Printf("|  %s(x%-2d,..,x%-2d)  ", name, 0, i-1)
+	}
+	fmt.Println("|")
+
+	format.PrintLine(k)
+
+	for i := 0; i < len(table); i++ {
+		for j := 0; j < len(table[i]); j++ {
+			fmt.Printf("| ")
+			if table[i][j] >= 0 {
+				fmt.Printf(" ")
+			}
+			fmt.Printf("%-14f ", table[i][j])
+			if table[i][j] < 0 {
+				fmt.Printf(" ")
+			}
+		}
+		for j := 0; j < len(table[0])-len(table[i]); j++ {
+			fmt.Printf("|                 ")
+		}
+		fmt.Println("|")
+	}
+
+	format.PrintLine(k)
+	fmt.Println()
+}
@@ -0,0 +1,348 @@
+package interpolation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/linalg"
+)
+
+// Shepard is the inverse distance weighted interpolation of z(x, y) by scattered points:
+// z(x, y) = sum(wi zi) / sum(wi), wi = 1 / di^power, di - the distance to the point i.
+type Shepard struct {
+	points [][]float64
+	power  float64
+}
+
+// CreateShepard() creates the Shepard interpolation, power > 0 (usually 2).
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+// points[i][2] - z coordinate.
+func CreateShepard(points [][]float64, power float64) (*Shepard, error) {
+	if power <= 0 {
+		return nil, ErrInvalidPower
+	}
+	copied, err := scatteredPoints(points, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &Shepard{points: copied, power: power}, nil
+}
+
+// Calc() calculates z(x, y).
+func (s *Shepard) Calc(x, y float64) float64 {
+	var sum, weights float64
+	for _, point := range s.points {
+		d := math.Hypot(x-point[0], y-point[1])
+		if d == 0 {
+			return point[2]
+		}
+		w := math.Pow(d, -s.power)
+		sum += w * point[2]
+		weights += w
+	}
+	return sum / weights
+}
+
+// ModifiedShepard is the modified Shepard interpolation (Franke-Little weights, Renka's nodal functions):
+// z(x, y) = sum(Wi Qi(x, y)) / sum(Wi), Wi = ((Ri - di)+ / (Ri di))^2,
+// Qi - the quadratic fitted by the weighted least squares to the nearest points and passing through the point i,
+// Ri - the distance to the nw-th nearest point.
+type ModifiedShepard struct {
+	points [][]float64
+	radius []float64
+	nodal  [][]float64 // the coefficients of (x - xi), (y - yi), (x - xi)^2, (x - xi)(y - yi), (y - yi)^2
+}
+
+// CreateModifiedShepard() creates the modified Shepard interpolation.
+// nq - the number of the nearest points for the nodal functions (usually 13).
+// nw - the number of the nearest points inside the radius of the weights (usually 19).
+// The points are the same as in CreateShepard() and must be distinct; nq and nw are reduced if there are not enough points.
+func CreateModifiedShepard(points [][]float64, nq, nw int) (*ModifiedShepard, error) {
+	if nq < 1 || nw < 1 {
+		return nil, ErrNotEnoughInputData
+	}
+	copied, err := scatteredPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+	if !distinct(copied) {
+		return nil, ErrDuplicatePoints
+	}
+	nq, nw = min(nq, len(copied)-1), min(nw, len(copied)-1)
+
+	s := &ModifiedShepard{
+		points: copied,
+		radius: make([]float64, len(copied)),
+		nodal:  make([][]float64, len(copied)),
+	}
+	for i, point := range copied {
+		neighbours := nearestScattered(copied, point[0], point[1], max(nq, nw)+1)[1:]
+		s.radius[i] = neighbours[nw-1].distance
+		s.nodal[i] = nodalQuadratic(copied, point, neighbours[:nq])
+	}
+	return s, nil
+}
+
+// Calc() calculates z(x, y). Outside the radii of all the points the nodal function of the nearest point is used.
+func (s *ModifiedShepard) Calc(x, y float64) float64 {
+	var sum, weights float64
+	nearest, nearestDist := 0, math.Inf(1)
+	for i, point := range s.points {
+		d := math.Hypot(x-point[0], y-point[1])
+		if d == 0 {
+			return point[2]
+		}
+		if d < nearestDist {
+			nearest, nearestDist = i, d
+		}
+		if d >= s.radius[i] {
+			continue
+		}
+		w := (s.radius[i] - d) / (s.radius[i] * d)
+		sum += w * w * s.nodalValue(i, x, y)
+		weights += w * w
+	}
+	if weights == 0 {
+		return s.nodalValue(nearest, x, y)
+	}
+	return sum / weights
+}
+
+// nodalValue() calculates the nodal function of the point i.
+func (s *ModifiedShepard) nodalValue(i int, x, y float64) float64 {
+	dx, dy := x-s.points[i][0], y-s.points[i][1]
+	c := s.nodal[i]
+	return s.points[i][2] + c[0]*dx + c[1]*dy + c[2]*dx*dx + c[3]*dx*dy + c[4]*dy*dy
+}
+
+// nodalQuadratic() fits the coefficients of the nodal quadratic of the point by the weighted least squares.
+// If the neighbours do not define a quadratic, a linear function is used, then a constant.
+func nodalQuadratic(points [][]float64, point []float64, neighbours []neighbour) []float64 {
+	radius := neighbours[len(neighbours)-1].distance * 1.01
+	for _, size := range []int{5, 2} {
+		if len(neighbours) < size {
+			continue
+		}
+		a := make([][]float64, size)
+		for i := 0; i < size; i++ {
+			a[i] = make([]float64, size)
+		}
+		b := make([]float64, size)
+		for _, n := range neighbours {
+			if n.distance == 0 {
+				continue
+			}
+			dx, dy := points[n.index][0]-point[0], points[n.index][1]-point[1]
+			basis := []float64{dx, dy, dx * dx, dx * dy, dy * dy}[:size]
+			w := (radius - n.distance) / (radius * n.distance)
+			w *= w
+			for i := 0; i < size; i++ {
+				for j := 0; j < size; j++ {
+					a[i][j] += w * basis[i] * basis[j]
+				}
+				b[i] += w * basis[i] * (points[n.index][2] - point[2])
+			}
+		}
+		if coefs, err := linalg.Solve(a, b); err == nil {
+			return append(coefs, make([]float64, 5-size)...)
+		}
+	}
+	return make([]float64, 5)
+}
+
+type neighbour struct {
+	index    int
+	distance float64
+}
+
+// nearestScattered() returns the k points nearest to (x, y) sorted by the distance.
+func nearestScattered(points [][]float64, x, y float64, k int) []neighbour {
+	result := make([]neighbour, len(points))
+	for i, point := range points {
+		result[i] = neighbour{index: i, distance: math.Hypot(x-point[0], y-point[1])}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].distance < result[j].distance
+	})
+	return result[:min(k, len(result))]
+}
+
+// RBFKernel is the radial basis function phi(r) of the RBF interpolation.
+type RBFKernel int
+
+const (
+	Gaussian     RBFKernel = iota // exp(-(eps r)^2)
+	Multiquadric                  // sqrt(1 + (eps r)^2)
+	ThinPlate                     // r^2 ln(r), needs the polynomial of degree >= 1
+)
+
+func (k RBFKernel) String() string {
+	names := []string{"Gaussian", "Multiquadric", "Thin-plate"}
+	if k < 0 || int(k) >= len(names) {
+		return "unknown"
+	}
+	return names[k]
+}
+
+// phi() calculates the kernel at the distance r.
+func (k RBFKernel) phi(r, eps float64) float64 {
+	switch k {
+	case Gaussian:
+		return math.Exp(-(eps * r) * (eps * r))
+	case Multiquadric:
+		return math.Sqrt(1 + (eps*r)*(eps*r))
+	default:
+		if r == 0 {
+			return 0
+		}
+		return r * r * math.Log(r)
+	}
+}
+
+// RBF is the radial basis function interpolation of z(x, y) by scattered points:
+// z(x, y) = sum(wi phi(|(x, y) - (xi, yi)|)) + p(x, y), p - the polynomial of degree 0 or 1 (optional).
+// The weights satisfy z(xi, yi) = zi and sum(wi q(xi, yi)) = 0 for every monomial q of p.
+type RBF struct {
+	points  [][]float64
+	kernel  RBFKernel
+	eps     float64
+	degree  int
+	weights []float64
+	poly    []float64 // the coefficients of 1, x, y
+}
+
+// CreateRBF() creates the RBF interpolation.
+// eps - the shape parameter of the Gaussian and the multiquadric (eps <= 0 - chosen by frankeShape()).
+// degree - the degree of the polynomial: -1 - none, 0 - constant, 1 - linear (ThinPlate needs degree 1).
+// The points are the same as in CreateShepard() and must be distinct.
+func CreateRBF(points [][]float64, kernel RBFKernel, eps float64, degree int) (*RBF, error) {
+	if kernel < Gaussian || kernel > ThinPlate {
+		return nil, ErrInvalidKernel
+	}
+	if degree < -1 || degree > 1 || kernel == ThinPlate && degree < 1 {
+		return nil, ErrInvalidPolynomialDegree
+	}
+	terms := []int{0, 1, 3}[degree+1]
+	copied, err := scatteredPoints(points, max(1, terms))
+	if err != nil {
+		return nil, err
+	}
+	if !distinct(copied) {
+		return nil, ErrDuplicatePoints
+	}
+	if eps <= 0 {
+		eps = frankeShape(copied)
+	}
+
+	n := len(copied)
+	a := make([][]float64, n+terms)
+	b := make([]float64, n+terms)
+	for i := 0; i < n+terms; i++ {
+		a[i] = make([]float64, n+terms)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			r := math.Hypot(copied[i][0]-copied[j][0], copied[i][1]-copied[j][1])
+			a[i][j] = kernel.phi(r, eps)
+		}
+		monomials := []float64{1, copied[i][0], copied[i][1]}
+		for k := 0; k < terms; k++ {
+			a[i][n+k], a[n+k][i] = monomials[k], monomials[k]
+		}
+		b[i] = copied[i][2]
+	}
+
+	solution, err := linalg.Solve(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return &RBF{
+		points:  copied,
+		kernel:  kernel,
+		eps:     eps,
+		degree:  degree,
+		weights: solution[:n],
+		poly:    append(solution[n:], make([]float64, 3-terms)...),
+	}, nil
+}
+
+// Calc() calculates z(x, y).
+func (r *RBF) Calc(x, y float64) float64 {
+	result := r.poly[0] + r.poly[1]*x + r.poly[2]*y
+	for i, point := range r.points {
+		result += r.weights[i] * r.kernel.phi(math.Hypot(x-point[0], y-point[1]), r.eps)
+	}
+	return result
+}
+
+// frankeShape() returns the shape parameter by the Franke's rule eps = sqrt(n) / (1.25 D),
+// D - the diagonal of the bounding box of the points.
+func frankeShape(points [][]float64) float64 {
+	xmin, xmax, ymin, ymax := points[0][0], points[0][0], points[0][1], points[0][1]
+	for _, point := range points {
+		xmin, xmax = min(xmin, point[0]), max(xmax, point[0])
+		ymin, ymax = min(ymin, point[1]), max(ymax, point[1])
+	}
+	diagonal := math.Hypot(xmax-xmin, ymax-ymin)
+	if diagonal == 0 {
+		return 1
+	}
+	return math.Sqrt(float64(len(points))) / (1.25 * diagonal)
+}
+
+// scatteredPoints() returns a copy of x, y, z of the points, at least minPoints + 1 points are required.
+func scatteredPoints(points [][]float64, minPoints int) ([][]float64, error) {
+	if len(points) < minPoints+1 {
+		return nil, ErrNotEnoughInputData
+	}
+	result := make([][]float64, len(points))
+	for i := 0; i < len(points); i++ {
+		if len(points[i]) < 3 {
+			return nil, ErrNotEnoughInputData
+		}
+		result[i] = append([]float64{}, points[i][:3]...)
+	}
+	return result, nil
+}
+
+// distinct() reports whether no two points have the same x and y.
+func distinct(points [][]float64) bool {
+	sorted := make([][]float64, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i][0] != sorted[j][0] {
+			return sorted[i][0] < sorted[j][0]
+		}
+		return sorted[i][1] < sorted[j][1]
+	})
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i][0] == sorted[i-1][0] && sorted[i][1] == sorted[i-1][1] {
+			return false
+		}
+	}
+	return true
+}
+
+type scatteredAdapter struct {
+	calc func(x, y float64) float64
+	name string
+}
+
+// ShepardAdapter() returns a Surface that evaluates the Shepard interpolation.
+func ShepardAdapter(shepard *Shepard) Surface {
+	return &scatteredAdapter{calc: shepard.Calc, name: "Shepard"}
+}
+
+// ModifiedShepardAdapter() returns a Surface that evaluates the modified Shepard interpolation.
+func ModifiedShepardAdapter(shepard *ModifiedShepard) Surface {
+	return &scatteredAdapter{calc: shepard.Calc, name: "Modified Shepard"}
+}
+
+// RBFAdapter() returns a Surface that evaluates the RBF interpolation.
+func RBFAdapter(rbf *RBF) Surface {
+	return &scatteredAdapter{calc: rbf.Calc, name: "RBF " + rbf.kernel.String()}
+}
+
+func (a *scatteredAdapter) Eval(x, y float64) (float64, error) { return a.calc(x, y), nil }
+func (a *scatteredAdapter) Name() string                       { return a.name }
@@ -0,0 +1,283 @@
+package interpolation
+
+import "math"
+
+// SmoothingSpline is the cubic smoothing spline (Reinsch).
+// It minimizes sum(wi (yi - g(xi))^2) + lambda * integral(g”(x)^2) and has natural end conditions.
+// lambda = 0 - the interpolating natural spline, lambda -> inf - the weighted least squares line.
+type SmoothingSpline struct {
+	piecewise
+	data   [][]float64 // x, y and the weight of the source points
+	lambda float64
+	trace  float64 // the trace of the influence matrix, the effective number of parameters
+}
+
+// CreateSmoothingSpline() creates the smoothing spline with the smoothing parameter lambda >= 0.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+// points[i][2] - the uncertainty (standard deviation) of y, the weight of the point is 1 / sigma^2 (optional).
+func CreateSmoothingSpline(points [][]float64, lambda float64) (*SmoothingSpline, error) {
+	if lambda < 0 {
+		return nil, ErrInvalidSmoothing
+	}
+	data, err := smoothingData(points)
+	if err != nil {
+		return nil, err
+	}
+	return fitSmoothing(data, lambda)
+}
+
+// CreateSmoothingSplineGCV() creates the smoothing spline with lambda minimizing the generalized cross-validation
+// score GCV(lambda) = n * sum(wi (yi - g(xi))^2) / (n - tr(A))^2, A - the influence matrix (g = A y).
+// The points are the same as in CreateSmoothingSpline().
+func CreateSmoothingSplineGCV(points [][]float64) (*SmoothingSpline, error) {
+	data, err := smoothingData(points)
+	if err != nil {
+		return nil, err
+	}
+
+	// lambda has the dimension of x^3, the search starts from the scale balancing the terms of the system.
+	q, r, _ := smoothingMatrices(data)
+	var trR, trQ float64
+	for j := 0; j < len(r); j++ {
+		trR += r[j]
+		for k := 0; k < 3; k++ {
+			trQ += q[j][k] * q[j][k] / data[j+k][2]
+		}
+	}
+	scale := math.Log10(trR / trQ)
+
+	var best *SmoothingSpline
+	score := func(logLambda float64) float64 {
+		s, err := fitSmoothing(data, math.Pow(10, logLambda))
+		if err != nil {
+			return math.Inf(1)
+		}
+		gcv := s.GCV()
+		if best == nil || gcv < best.GCV() {
+			best = s
+		}
+		return gcv
+	}
+
+	// A coarse grid, then the golden section search around the best node.
+	const steps = 24
+	from, to := scale-6, scale+6
+	step := (to - from) / steps
+	bestNode, bestScore := from, math.Inf(1)
+	for i := 0; i <= steps; i++ {
+		if gcv := score(from + float64(i)*step); gcv < bestScore {
+			bestNode, bestScore = from+float64(i)*step, gcv
+		}
+	}
+	goldenSection(score, bestNode-step, bestNode+step, 1e-4)
+
+	if best == nil {
+		return nil, ErrSingularSystem
+	}
+	return best, nil
+}
+
+// Lambda() returns the smoothing parameter.
+func (s *SmoothingSpline) Lambda() float64 {
+	return s.lambda
+}
+
+// Smoothed() returns the points of the spline at the source x.
+func (s *SmoothingSpline) Smoothed() [][]float64 {
+	return copyPoints(s.points)
+}
+
+// GCV() returns the generalized cross-validation score of the spline.
+func (s *SmoothingSpline) GCV() float64 {
+	n := float64(len(s.data))
+	var rss float64
+	for i := 0; i < len(s.data); i++ {
+		diff := s.data[i][1] - s.points[i][1]
+		rss += s.data[i][2] * diff * diff
+	}
+	return n * rss / ((n - s.trace) * (n - s.trace))
+}
+
+// smoothingData() returns the sorted points with the weights in the third column.
+func smoothingData(points [][]float64) ([][]float64, error) {
+	if len(points) < 3 {
+		return nil, ErrNotEnoughInputData
+	}
+	columns := 2
+	if len(points[0]) > 2 {
+		columns = 3
+	}
+	data, err := sortedPoints(points, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(data); i++ {
+		if i > 0 && data[i][0] == data[i-1][0] {
+			return nil, ErrSingularSystem
+		}
+		weight := 1.0
+		if columns == 3 {
+			if data[i][2] <= 0 {
+				return nil, ErrInvalidWeight
+			}
+			weight = 1 / (data[i][2] * data[i][2])
+		}
+		data[i] = []float64{data[i][0], data[i][1], weight}
+	}
+	return data, nil
+}
+
+// smoothingMatrices() returns the band matrices Q (n x n-2) and R (n-2 x n-2) of the Reinsch algorithm:
+// the second derivatives gamma at the inner points satisfy Q^T g = R gamma.
+// q[j] - the non-zero elements of the column j of Q (the rows j, j+1, j+2),
+// r[j] - the diagonal of R, rOff[j] - the elements R[j][j+1] == R[j+1][j].
+func smoothingMatrices(data [][]float64) ([][3]float64, []float64, []float64) {
+	n := len(data)
+	h := make([]float64, n-1)
+	for i := 0; i < n-1; i++ {
+		h[i] = data[i+1][0] - data[i][0]
+	}
+
+	q := make([][3]float64, n-2)
+	r, rOff := make([]float64, n-2), make([]float64, n-2)
+	for j := 0; j < n-2; j++ {
+		q[j] = [3]float64{1 / h[j], -1/h[j] - 1/h[j+1], 1 / h[j+1]}
+		r[j] = (h[j] + h[j+1]) / 3
+		if j+1 < n-2 {
+			rOff[j] = h[j+1] / 6
+		}
+	}
+	return q, r, rOff
+}
+
+// fitSmoothing() calculates the spline: (R + lambda Q^T W^-1 Q) gamma = Q^T y, g = y - lambda W^-1 Q gamma.
+// The matrix M = R + lambda Q^T W^-1 Q is pentadiagonal and positive definite, so the system is solved
+// by the band LDL^T decomposition and the band of M^-1 needed for the trace of the influence matrix
+// is calculated by the Hutchinson-de Hoog recursion, all in O(n).
+func fitSmoothing(data [][]float64, lambda float64) (*SmoothingSpline, error) {
+	n := len(data)
+	size := n - 2
+	q, r, rOff := smoothingMatrices(data)
+
+	// The diagonals of M: m0[j] = M[j][j], m1[j] = M[j][j+1], m2[j] = M[j][j+2].
+	m0, m1, m2 := make([]float64, size), make([]float64, size), make([]float64, size)
+	rhs := make([]float64, size)
+	for j := 0; j < size; j++ {
+		for k := 0; k < 3; k++ {
+			m0[j] += q[j][k] * q[j][k] / data[j+k][2]
+			rhs[j] += q[j][k] * data[j+k][1]
+		}
+		if j+1 < size {
+			m1[j] = q[j][1]*q[j+1][0]/data[j+1][2] + q[j][2]*q[j+1][1]/data[j+2][2]
+		}
+		if j+2 < size {
+			m2[j] = q[j][2] * q[j+2][0] / data[j+2][2]
+		}
+		m0[j] = r[j] + lambda*m0[j]
+		m1[j] = rOff[j] + lambda*m1[j]
+		m2[j] *= lambda
+	}
+
+	// M = L D L^T, L is unit lower triangular with the subdiagonals e and f.
+	d, e, f := make([]float64, size), make([]float64, size), make([]float64, size)
+	for j := 0; j < size; j++ {
+		d[j] = m0[j]
+		if j > 0 {
+			d[j] -= e[j-1] * e[j-1] * d[j-1]
+		}
+		if j > 1 {
+			d[j] -= f[j-2] * f[j-2] * d[j-2]
+		}
+		if d[j] <= 0 {
+			return nil, ErrSingularSystem
+		}
+		e[j] = m1[j]
+		if j > 0 {
+			e[j] -= f[j-1] * d[j-1] * e[j-1]
+		}
+		e[j] /= d[j]
+		f[j] = m2[j] / d[j]
+	}
+
+	gamma := make([]float64, n)
+	z := make([]float64, size)
+	for j := 0; j < size; j++ {
+		z[j] = rhs[j]
+		if j > 0 {
+			z[j] -= e[j-1] * z[j-1]
+		}
+		if j > 1 {
+			z[j] -= f[j-2] * z[j-2]
+		}
+	}
+	for j := size - 1; j >= 0; j-- {
+		gamma[j+1] = z[j]/d[j] - e[j]*gamma[j+2]
+		if j+2 < size {
+			gamma[j+1] -= f[j] * gamma[j+3]
+		}
+	}
+
+	// The band of B = M^-1 from B = D^-1 L^-1 + (I - L^T) B: b0[j] = B[j][j], b1[j] = B[j][j+1], b2[j] = B[j][j+2].
+	b0, b1, b2 := make([]float64, size+2), make([]float64, size+2), make([]float64, size+2)
+	for j := size - 1; j >= 0; j-- {
+		b2[j] = -e[j]*b1[j+1] - f[j]*b0[j+2]
+		b1[j] = -e[j]*b0[j+1] - f[j]*b1[j+1]
+		b0[j] = 1/d[j] - e[j]*b1[j] - f[j]*b2[j]
+	}
+	band := func(j, k int) float64 {
+		if j > k {
+			j, k = k, j
+		}
+		return []float64{b0[j], b1[j], b2[j]}[k-j]
+	}
+
+	// tr(A) = n - lambda * sum(qi M^-1 qi^T / wi), qi - the row i of Q.
+	s := &SmoothingSpline{data: data, lambda: lambda, trace: float64(n)}
+	points := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		var qGamma, qMq float64
+		for j := max(0, i-2); j <= min(size-1, i); j++ {
+			qGamma += q[j][i-j] * gamma[j+1]
+			for k := max(0, i-2); k <= min(size-1, i); k++ {
+				qMq += q[j][i-j] * band(j, k) * q[k][i-k]
+			}
+		}
+		points[i] = []float64{data[i][0], data[i][1] - lambda*qGamma/data[i][2]}
+		s.trace -= lambda * qMq / data[i][2]
+	}
+
+	config := make([][]float64, n)
+	for i := 1; i < n; i++ {
+		h := points[i][0] - points[i-1][0]
+		config[i] = []float64{
+			points[i-1][1],
+			(points[i][1]-points[i-1][1])/h - h*(2*gamma[i-1]+gamma[i])/6,
+			gamma[i-1] / 2,
+			(gamma[i] - gamma[i-1]) / (6 * h),
+		}
+	}
+	s.piecewise = piecewise{points: points, config: config}
+
+	return s, nil
+}
+
+// goldenSection() searches the minimum of the unimodal function f on [a, b] with the precision eps.
+func goldenSection(f func(float64) float64, a, b, eps float64) float64 {
+	ratio := (math.Sqrt(5) - 1) / 2
+	x1, x2 := b-ratio*(b-a), a+ratio*(b-a)
+	f1, f2 := f(x1), f(x2)
+	for b-a > eps {
+		if f1 < f2 {
+			b, x2, f2 = x2, x1, f1
+			x1 = b - ratio*(b-a)
+			f1 = f(x1)
+		} else {
+			a, x1, f1 = x1, x2, f2
+			x2 = a + ratio*(b-a)
+			f2 = f(x2)
+		}
+	}
+	return (a + b) / 2
+}
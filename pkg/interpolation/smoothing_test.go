@@ -0,0 +1,62 @@
+package interpolation
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// noisyPoints() returns the points x, y, sigma of sin(x) with the noise and the uncertainties sigma.
+func noisyPoints(seed int64, n int) [][]float64 {
+	r := rand.New(rand.NewSource(seed))
+	points := make([][]float64, n)
+	x := 0.0
+	for i := 0; i < n; i++ {
+		x += 0.1 + 0.4*r.Float64()
+		sigma := 0.05 + 0.2*r.Float64()
+		points[i] = []float64{x, math.Sin(x) + sigma*r.NormFloat64(), sigma}
+	}
+	return points
+}
+
+func TestSmoothingSplineInterpolates(t *testing.T) {
+	points := noisyPoints(1, 30)
+	smoothing, err := CreateSmoothingSpline(points, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spline, err := CreateSpline(points)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for x := points[0][0]; x <= points[len(points)-1][0]; x += 0.01 {
+		if got, expected := smoothing.Calc(x), spline.Calc(x); math.Abs(got-expected) > 1e-9 {
+			t.Fatalf("lambda = 0: g(%g) = %g, the natural spline %g", x, got, expected)
+		}
+	}
+}
+
+func TestSmoothingSplineLine(t *testing.T) {
+	points := noisyPoints(2, 30)
+	smoothing, err := CreateSmoothingSpline(points, 1e12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The weighted least squares line y = a + b x, the weights 1 / sigma^2.
+	var sw, swx, swy, swxx, swxy float64
+	for _, p := range points {
+		w := 1 / (p[2] * p[2])
+		sw, swx, swy = sw+w, swx+w*p[0], swy+w*p[1]
+		swxx, swxy = swxx+w*p[0]*p[0], swxy+w*p[0]*p[1]
+	}
+	b := (sw*swxy - swx*swy) / (sw*swxx - swx*swx)
+	a := (swy - b*swx) / sw
+
+	for x := points[0][0]; x <= points[len(points)-1][0]; x += 0.01 {
+		if got, expected := smoothing.Calc(x), a+b*x; math.Abs(got-expected) > 1e-6 {
+			t.Fatalf("lambda -> inf: g(%g) = %g, the line %g", x, got, expected)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package interpolation
+
+import (
+	"math"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/linalg"
+)
+
+// Spline is a cubic spline fitted for the given boundary conditions.
+// A Spline is never modified after creation, so it can be evaluated from several goroutines.
+type Spline struct {
+	piecewise
+}
+
+// CreateSpline() creates a cubic spline with natural boundary conditions (S”(x0) == S”(xn) == 0).
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateSpline(points [][]float64) (*Spline, error) {
+	if len(points) < 2 {
+		return nil, ErrNotEnoughInputData
+	}
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	return fitSpline(sorted, NaturalCond(), NaturalCond())
+}
+
+// Fit() returns a new spline through the same points with the boundary conditions startC, endC.
+// startC, endC - C1 and Cn+1 (half of the second derivative at the ends): startC is set at x0, endC at xn.
+// The earlier sweep also mixed endC into its first step, so the splines with endC != 0 differ from it
+// (lab_02 SetStartEnd). The original spline is not changed.
+func (s *Spline) Fit(startC, endC float64) (*Spline, error) {
+	return fitSpline(s.points, SecondDerivativeCond(2*startC), SecondDerivativeCond(2*endC))
+}
+
+// FitWith() returns a new spline through the same points with the boundary conditions start and end.
+// Periodic conditions must be set on both ends. The original spline is not changed.
+func (s *Spline) FitWith(start, end BoundaryCondition) (*Spline, error) {
+	return fitSpline(s.points, start, end)
+}
+
+func fitSpline(points [][]float64, start, end BoundaryCondition) (*Spline, error) {
+	if (start.Kind == Periodic) != (end.Kind == Periodic) {
+		return nil, ErrInvalidBoundaryCond
+	}
+	s := &Spline{piecewise{
+		points: points,
+		// Config starts from 1 index
+		config: make([][]float64, len(points)),
+	}}
+
+	var c []float64
+	var err error
+	if start.Kind == Periodic {
+		c, err = s.periodicC()
+	} else {
+		// Метод прогонки, вычисляет коэффициенты Ci
+		c, err = s.shuttle(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Вычисляет значение коэффициентов a, b, d
+	s.configure(c)
+
+	return s, nil
+}
+
+// Hi = Xi - Xi-1
+func (s *Spline) paramH(idx int) float64 {
+	return s.points[idx][0] - s.points[idx-1][0]
+}
+
+// Fi = 3 * ((yi - yi-1) / hi - (yi-1 - yi-2) / hi-1)
+func (s *Spline) paramF(idx int, h1, h2 float64) float64 {
+
+	a := (s.points[idx][1] - s.points[idx-1][1]) / h2
+	b := (s.points[idx-1][1] - s.points[idx-2][1]) / h1
+
+	return 3 * (a - b)
+}
+
+// slope() returns (yi - yi-1) / hi
+func (s *Spline) slope(idx int) float64 {
+	return (s.points[idx][1] - s.points[idx-1][1]) / s.paramH(idx)
+}
+
+// shuttle() builds the system hi-1 * ci-1 + 2(hi-1 + hi) * ci + hi * ci+1 = Fi for ci = S”(xi) / 2
+// with the rows of the boundary conditions and solves it by the sweep method.
+func (s *Spline) shuttle(start, end BoundaryCondition) ([]float64, error) {
+	n := len(s.points)
+	if (start.Kind == NotAKnot || end.Kind == NotAKnot) && n < 4 {
+		return nil, ErrNotEnoughInputData
+	}
+
+	lower, diag, upper, rhs := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		h1, h2 := s.paramH(i), s.paramH(i+1)
+		lower[i], diag[i], upper[i] = h1, 2*(h1+h2), h2
+		rhs[i] = s.paramF(i+1, h1, h2)
+	}
+
+	switch start.Kind {
+	case Natural, SecondDerivative:
+		diag[0], rhs[0] = 1, start.Value/2
+	case Clamped:
+		h := s.paramH(1)
+		diag[0], upper[0], rhs[0] = 2*h, h, 3*(s.slope(1)-start.Value)
+	case NotAKnot:
+		// d0 == d1, c0 = ((h0 + h1) * c1 - h0 * c2) / h1 is excluded from the second row.
+		h1, h2 := s.paramH(1), s.paramH(2)
+		diag[0] = 1
+		lower[1], diag[1], upper[1] = 0, h1+2*h2, h2-h1
+		rhs[1] *= h2 / (h1 + h2)
+	}
+
+	switch end.Kind {
+	case Natural, SecondDerivative:
+		diag[n-1], rhs[n-1] = 1, end.Value/2
+	case Clamped:
+		h := s.paramH(n - 1)
+		lower[n-1], diag[n-1], rhs[n-1] = h, 2*h, 3*(end.Value-s.slope(n-1))
+	case NotAKnot:
+		// dn-2 == dn-1, cn-1 = ((hn-2 + hn-1) * cn-2 - hn-1 * cn-3) / hn-2 is excluded from the row n - 2.
+		h1, h2 := s.paramH(n-2), s.paramH(n-1)
+		diag[n-1] = 1
+		lower[n-2], diag[n-2], upper[n-2] = h1-h2, 2*h1+h2, 0
+		rhs[n-2] *= h1 / (h1 + h2)
+	}
+
+	// The sweep does not pivot, the clamped and not-a-knot rows are dominant only for positive steps.
+	if start.Kind == Clamped || start.Kind == NotAKnot || end.Kind == Clamped || end.Kind == NotAKnot {
+		if !linalg.CheckDominance(lower, diag, upper, false).Dominant {
+			return nil, ErrNotDominant
+		}
+	}
+	c, err := linalg.SolveTridiagonal(lower, diag, upper, rhs)
+	if err != nil {
+		return nil, err
+	}
+
+	if start.Kind == NotAKnot {
+		h1, h2 := s.paramH(1), s.paramH(2)
+		c[0] = ((h1+h2)*c[1] - h1*c[2]) / h2
+	}
+	if end.Kind == NotAKnot {
+		h1, h2 := s.paramH(n-2), s.paramH(n-1)
+		c[n-1] = ((h1+h2)*c[n-2] - h2*c[n-3]) / h1
+	}
+
+	return c, nil
+}
+
+// periodicC() solves the cyclic system for ci with cn == c0 (S, S' and S” are equal at the ends).
+func (s *Spline) periodicC() ([]float64, error) {
+	n := len(s.points)
+	if n < 3 {
+		return nil, ErrNotEnoughInputData
+	}
+	if math.Abs(s.points[0][1]-s.points[n-1][1]) > delta {
+		return nil, ErrNotPeriodic
+	}
+
+	// Unknowns c0..cn-2, the row 0 joins the last and the first segments.
+	m := n - 1
+	lower, diag, upper, rhs := make([]float64, m), make([]float64, m), make([]float64, m), make([]float64, m)
+	for i := 0; i < m; i++ {
+		var h1 float64
+		if i == 0 {
+			h1 = s.paramH(n - 1)
+		} else {
+			h1 = s.paramH(i)
+		}
+		h2 := s.paramH(i + 1)
+		lower[i], diag[i], upper[i] = h1, 2*(h1+h2), h2
+		if i == 0 {
+			rhs[i] = 3 * (s.slope(1) - s.slope(n-1))
+		} else {
+			rhs[i] = s.paramF(i+1, h1, h2)
+		}
+	}
+
+	if !linalg.CheckDominance(lower, diag, upper, true).Dominant {
+		return nil, ErrNotDominant
+	}
+	c, err := linalg.SolveCyclicTridiagonal(lower, diag, upper, rhs)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(c, c[0]), nil
+}
+
+// calcB() returns Bi = (yi - yi-1) / hi - hi * (ci + 2ci-1) / 3
+func (s *Spline) calcB(idx int, h float64, c []float64) float64 {
+	return (s.points[idx][1]-s.points[idx-1][1])/h -
+		h*(c[idx]+2*c[idx-1])/3
+}
+
+// calcD() returns Di = (ci - ci-1) / 3hi
+func (s *Spline) calcD(idx int, h float64, c []float64) float64 {
+	return (c[idx] - c[idx-1]) / (3.0 * h)
+}
+
+// configure() calculates the coefficients of the segments, c[i] - the coefficient C at xi.
+func (s *Spline) configure(c []float64) {
+	for i := 1; i < len(s.config); i++ {
+		s.config[i] = make([]float64, 4)
+		h := s.paramH(i)
+		// set Ai
+		s.config[i][0] = s.points[i-1][1]
+		// set Bi
+		s.config[i][1] = s.calcB(i, h, c)
+		// set Ci
+		s.config[i][2] = c[i-1]
+		// set Di
+		s.config[i][3] = s.calcD(i, h, c)
+	}
+}
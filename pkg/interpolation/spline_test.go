@@ -0,0 +1,128 @@
+package interpolation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSplineReproducesCubic(t *testing.T) {
+	xs := []float64{-1, -0.6, 0.1, 0.3, 1.2, 1.5, 2.4, 3}
+	x0, xn := xs[0], xs[len(xs)-1]
+	kinds := []BoundaryKind{Natural, SecondDerivative, Clamped, NotAKnot}
+
+	for _, start := range kinds {
+		for _, end := range kinds {
+			// The natural end needs S'' == 0 there, the cubic is chosen accordingly.
+			var f, d1, d2 func(x float64) float64
+			switch {
+			case start == Natural && end == Natural:
+				f = func(x float64) float64 { return 2*x - 1 }
+				d1 = func(x float64) float64 { return 2 }
+				d2 = func(x float64) float64 { return 0 }
+			case start == Natural:
+				f = func(x float64) float64 { return math.Pow(x-x0, 3) - x + 2 }
+				d1 = func(x float64) float64 { return 3*(x-x0)*(x-x0) - 1 }
+				d2 = func(x float64) float64 { return 6 * (x - x0) }
+			case end == Natural:
+				f = func(x float64) float64 { return -math.Pow(x-xn, 3) + 0.5*x }
+				d1 = func(x float64) float64 { return -3*(x-xn)*(x-xn) + 0.5 }
+				d2 = func(x float64) float64 { return -6 * (x - xn) }
+			default:
+				f = func(x float64) float64 { return x*x*x - 2*x*x + 0.5*x + 1 }
+				d1 = func(x float64) float64 { return 3*x*x - 4*x + 0.5 }
+				d2 = func(x float64) float64 { return 6*x - 4 }
+			}
+			condition := func(kind BoundaryKind, x float64) BoundaryCondition {
+				switch kind {
+				case SecondDerivative:
+					return SecondDerivativeCond(d2(x))
+				case Clamped:
+					return ClampedCond(d1(x))
+				case NotAKnot:
+					return NotAKnotCond()
+				}
+				return NaturalCond()
+			}
+
+			points := make([][]float64, len(xs))
+			for i, x := range xs {
+				points[i] = []float64{x, f(x)}
+			}
+			spline, err := CreateSpline(points)
+			if err != nil {
+				t.Fatal(err)
+			}
+			spline, err = spline.FitWith(condition(start, x0), condition(end, xn))
+			if err != nil {
+				t.Fatalf("%v, %v: %v", start, end, err)
+			}
+			for x := x0; x <= xn; x += 0.01 {
+				if got := spline.Calc(x); math.Abs(got-f(x)) > 1e-10 {
+					t.Fatalf("%v, %v: S(%g) = %g, expected %g", start, end, x, got, f(x))
+				}
+			}
+		}
+	}
+}
+
+func TestSplineFit(t *testing.T) {
+	// Fit() sets C1 at x0 and Cn+1 at xn, C == S'' / 2.
+	f := func(x float64) float64 { return x*x*x - x }
+	points := make([][]float64, 6)
+	for i := range points {
+		x := float64(i) / 2
+		points[i] = []float64{x, f(x)}
+	}
+	spline, err := CreateSpline(points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spline, err = spline.Fit(0, 3*2.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for x := 0.0; x <= 2.5; x += 0.01 {
+		if got := spline.Calc(x); math.Abs(got-f(x)) > 1e-10 {
+			t.Fatalf("S(%g) = %g, expected %g", x, got, f(x))
+		}
+	}
+}
+
+func TestSplinePeriodic(t *testing.T) {
+	points := make([][]float64, 13)
+	for i := range points {
+		x := 2 * math.Pi * float64(i) / float64(len(points)-1)
+		points[i] = []float64{x, math.Sin(x)}
+	}
+	points[len(points)-1][1] = points[0][1]
+	spline, err := CreateSpline(points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spline, err = spline.FitWith(PeriodicCond(), PeriodicCond())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x0, xn := points[0][0], points[len(points)-1][0]
+	for order := 1; order <= 2; order++ {
+		if start, end := spline.derivative(x0, order), spline.derivative(xn, order); math.Abs(start-end) > 1e-10 {
+			t.Fatalf("the derivative of order %d: %g at x0, %g at xn", order, start, end)
+		}
+	}
+	if d := spline.derivative(x0, 1); math.Abs(d-1) > 1e-2 {
+		t.Fatalf("S'(x0) = %g, expected about cos(0) == 1", d)
+	}
+}
+
+func TestSplineNotDominant(t *testing.T) {
+	// The nodes are not sorted, the steps are negative and the rows of the systems are not dominant.
+	points := [][]float64{{0, 1}, {1, 2}, {0.5, 0}, {2, 3}, {3, 1}}
+	spline := &Spline{piecewise{points: points, config: make([][]float64, len(points))}}
+	if _, err := spline.FitWith(PeriodicCond(), PeriodicCond()); err != ErrNotDominant {
+		t.Fatalf("periodic: %v, expected %v", err, ErrNotDominant)
+	}
+	if _, err := spline.FitWith(ClampedCond(0), NotAKnotCond()); err != ErrNotDominant {
+		t.Fatalf("clamped, not-a-knot: %v, expected %v", err, ErrNotDominant)
+	}
+}
@@ -0,0 +1,124 @@
+package interpolation
+
+import "math"
+
+// Thiele implements the interpolation by the Thiele continued fraction
+// y(x) = a0 + (x - x0) / (a1 + (x - x1) / (a2 + ... + (x - xn-1) / an)),
+// the coefficients are built from the reciprocal differences.
+type Thiele struct {
+	points [][]float64
+}
+
+// CreateThiele() creates a Thiele structure that implements interpolation by the Thiele continued fraction.
+// points[i][0] - x coordinate.
+// points[i][1] - y coordinate.
+func CreateThiele(points [][]float64) (*Thiele, error) {
+	sorted, err := sortedPoints(points, 2)
+	if err != nil {
+		return nil, err
+	}
+	return &Thiele{points: sorted}, nil
+}
+
+// Calc() calculates the approximate value of y(x) by the continued fraction through n + 1 points.
+// x - the input value.
+// n - the number of points minus one.
+func (th *Thiele) Calc(x float64, n int) (float64, error) {
+	res, err := th.Evaluate(x, n)
+	if err != nil {
+		return UndefNum, err
+	}
+	return res.Value, nil
+}
+
+// Evaluate() calculates y(x) and returns it together with the nodes and the table of reciprocal differences.
+// n + 1 points, as close as possible to x, are used (the same as for the Newton polynomial).
+// x - the input value.
+// n - the number of points minus one.
+func (th *Thiele) Evaluate(x float64, n int) (Result, error) {
+	if n < 0 {
+		return Result{Value: UndefNum}, ErrInvalidPolynomialDegree
+	}
+	if len(th.points) <= n {
+		return Result{Value: UndefNum}, ErrNotEnoughInputData
+	}
+
+	start, end := nearestNodes(th.points, x, n)
+	nodes := copyPoints(th.points[start:end])
+	differences, err := buildReciprocalDiff(nodes)
+	if err != nil {
+		return Result{Value: UndefNum}, err
+	}
+	value, err := thieleResult(differences, x)
+	if err != nil {
+		return Result{Value: UndefNum}, err
+	}
+
+	return Result{
+		Value:       value,
+		Nodes:       nodes,
+		Differences: differences,
+		symbol:      "p",
+	}, nil
+}
+
+// buildReciprocalDiff() calculates the table of reciprocal differences.
+// p(xi,..,xi+k) = (xi - xi+k) / (p(xi,..,xi+k-1) - p(xi+1,..,xi+k)) + p(xi+1,..,xi+k-1).
+// table[i] - x, y and the reciprocal differences p(xi,..,xi+k).
+func buildReciprocalDiff(nodes [][]float64) ([][]float64, error) {
+	numOfNodes := len(nodes)
+
+	table := make([][]float64, numOfNodes)
+	for i := 0; i < numOfNodes; i++ {
+		table[i] = make([]float64, 2, 2+numOfNodes-i-1)
+		copy(table[i], nodes[i][:2])
+	}
+
+	for k := 1; k < len(nodes); k++ {
+		for i := 0; i < numOfNodes-1; i++ {
+			diff := (table[i][0] - table[i+k][0]) / (table[i][k] - table[i+1][k])
+			if k > 1 {
+				diff += table[i+1][k-1]
+			}
+			if math.IsInf(diff, 0) || math.IsNaN(diff) {
+				return nil, ErrInfiniteReciprocalDiff
+			}
+			table[i] = append(table[i], diff)
+		}
+		numOfNodes--
+	}
+
+	return table, nil
+}
+
+// thieleResult() calculates the continued fraction from the bottom,
+// a0 = y0, a1 = p(x0,x1), ak = p(x0,..,xk) - p(x0,..,xk-2).
+func thieleResult(table [][]float64, x float64) (float64, error) {
+	n := len(table[0]) - 2
+	coeff := func(k int) float64 {
+		if k < 2 {
+			return table[0][k+1]
+		}
+		return table[0][k+1] - table[0][k-1]
+	}
+
+	result := coeff(n)
+	for k := n - 1; k >= 0; k-- {
+		if result == 0 {
+			return UndefNum, ErrPole
+		}
+		result = coeff(k) + (x-table[k][0])/result
+	}
+
+	return result, nil
+}
+
+// PrintDiffTable() prints a table of the reciprocal differences for x and n + 1 points.
+func (th *Thiele) PrintDiffTable(x float64, n int) error {
+	res, err := th.Evaluate(x, n)
+	if err != nil {
+		return err
+	}
+	res.PrintDiffTable()
+	return nil
+}
@@ -0,0 +1,158 @@
+// Package linalg contains the solvers of the systems of linear equations shared by the interpolation
+// and the approximation methods.
+package linalg
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrSingularSystem = errors.New("the system of equations is singular")
+
+const epsilon = 0x1p-52 // the machine epsilon of float64
+
+// Solve() solves the system a * x = b by the Gaussian elimination with partial pivoting.
+// a and b are not changed. The system is singular if a pivot is not greater than eps * n * max|a[i][j]|
+// over the row it comes from.
+func Solve(a [][]float64, b []float64) ([]float64, error) {
+	n := len(b)
+	m := make([][]float64, n)
+	scale := make([]float64, n)
+	for i := 0; i < n; i++ {
+		m[i] = make([]float64, n+1)
+		copy(m[i], a[i])
+		m[i][n] = b[i]
+		for j := 0; j < n; j++ {
+			scale[i] = math.Max(scale[i], math.Abs(a[i][j]))
+		}
+	}
+	tolerance := epsilon * float64(n)
+
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(m[i][k]) > math.Abs(m[pivot][k]) {
+				pivot = i
+			}
+		}
+		if math.Abs(m[pivot][k]) <= tolerance*scale[pivot] {
+			return nil, ErrSingularSystem
+		}
+		m[k], m[pivot] = m[pivot], m[k]
+		scale[k], scale[pivot] = scale[pivot], scale[k]
+
+		for i := k + 1; i < n; i++ {
+			factor := m[i][k] / m[k][k]
+			for j := k; j <= n; j++ {
+				m[i][j] -= factor * m[k][j]
+			}
+		}
+	}
+
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := m[i][n]
+		for j := i + 1; j < n; j++ {
+			sum -= m[i][j] * x[j]
+		}
+		x[i] = sum / m[i][i]
+	}
+	return x, nil
+}
+
+// Inverse() calculates the inverse matrix by solving a * x = ej for every column ej of the identity matrix.
+func Inverse(a [][]float64) ([][]float64, error) {
+	n := len(a)
+	inverse := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		inverse[i] = make([]float64, n)
+	}
+
+	e := make([]float64, n)
+	for j := 0; j < n; j++ {
+		e[j] = 1
+		column, err := Solve(a, e)
+		if err != nil {
+			return nil, err
+		}
+		e[j] = 0
+		for i := 0; i < n; i++ {
+			inverse[i][j] = column[i]
+		}
+	}
+	return inverse, nil
+}
+
+// SolveLeastSquares() solves the overdetermined system a * x = b (m x n, m >= n) in the least squares sense
+// by the Householder QR decomposition: a = QR, R x = Q^T b. a and b are not changed.
+// Returns ErrSingularSystem if the columns of a are linearly dependent.
+func SolveLeastSquares(a [][]float64, b []float64) ([]float64, error) {
+	m := len(b)
+	if m == 0 || len(a[0]) > m {
+		return nil, ErrSingularSystem
+	}
+	n := len(a[0])
+
+	r := make([][]float64, m)
+	for i := 0; i < m; i++ {
+		r[i] = append([]float64{}, a[i]...)
+	}
+	qtb := append([]float64{}, b...)
+
+	var scale float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			scale = math.Max(scale, math.Abs(r[i][j]))
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		// The Householder reflection v zeroes r[k+1:][k].
+		var norm float64
+		for i := k; i < m; i++ {
+			norm = math.Hypot(norm, r[i][k])
+		}
+		if norm <= 1e-13*scale {
+			return nil, ErrSingularSystem
+		}
+		if r[k][k] > 0 {
+			norm = -norm
+		}
+		v := make([]float64, m-k)
+		for i := k; i < m; i++ {
+			v[i-k] = r[i][k]
+		}
+		v[0] -= norm
+		var vv float64
+		for i := 0; i < len(v); i++ {
+			vv += v[i] * v[i]
+		}
+
+		for j := k; j < n; j++ {
+			var dot float64
+			for i := k; i < m; i++ {
+				dot += v[i-k] * r[i][j]
+			}
+			for i := k; i < m; i++ {
+				r[i][j] -= 2 * dot / vv * v[i-k]
+			}
+		}
+		var dot float64
+		for i := k; i < m; i++ {
+			dot += v[i-k] * qtb[i]
+		}
+		for i := k; i < m; i++ {
+			qtb[i] -= 2 * dot / vv * v[i-k]
+		}
+	}
+
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := qtb[i]
+		for j := i + 1; j < n; j++ {
+			sum -= r[i][j] * x[j]
+		}
+		x[i] = sum / r[i][i]
+	}
+	return x, nil
+}
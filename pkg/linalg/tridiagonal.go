@@ -0,0 +1,152 @@
+package linalg
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrInvalidSize = errors.New("the diagonals and the right side must have the same length")
+
+// singular is the relative size of the pivot below which the tridiagonal system is considered singular.
+const singular float64 = 1e-14
+
+// Dominance is the result of the check of the diagonal dominance |diag[i]| >= |lower[i]| + |upper[i]|.
+// If the matrix is dominant with at least one strict row (and irreducible, all the side elements are not zero),
+// it is not singular and the sweep method is stable: |ksi[i]| <= 1 without pivoting.
+type Dominance struct {
+	Dominant bool    // the dominance holds in every row
+	Strict   bool    // the dominance is strict in every row
+	Row      int     // the row with the smallest margin
+	Margin   float64 // (|diag[i]| - |lower[i]| - |upper[i]|) / |diag[i]| in the row, negative - not dominant
+}
+
+// CheckDominance() checks the diagonal dominance of the tridiagonal matrix.
+// cyclic - lower[0] and upper[n-1] are the corner elements (as in SolveCyclicTridiagonal()), otherwise not used.
+func CheckDominance(lower, diag, upper []float64, cyclic bool) Dominance {
+	result := Dominance{Dominant: true, Strict: true, Margin: math.Inf(1)}
+	n := len(diag)
+	for i := 0; i < n; i++ {
+		var side float64
+		if i > 0 || cyclic {
+			side += math.Abs(lower[i])
+		}
+		if i < n-1 || cyclic {
+			side += math.Abs(upper[i])
+		}
+
+		margin := math.Inf(-1)
+		if diag[i] != 0 {
+			margin = (math.Abs(diag[i]) - side) / math.Abs(diag[i])
+		} else if side == 0 {
+			margin = 0
+		}
+		if margin < result.Margin {
+			result.Row, result.Margin = i, margin
+		}
+		result.Dominant = result.Dominant && margin >= 0
+		result.Strict = result.Strict && margin > 0
+	}
+	return result
+}
+
+// SolveTridiagonal() solves the system lower[i] * x[i-1] + diag[i] * x[i] + upper[i] * x[i+1] = rhs[i]
+// by the sweep method (Thomas algorithm), lower[0] and upper[n-1] are not used.
+// The method does not pivot, so it is stable for diagonally dominant matrices (see CheckDominance()).
+// Returns ErrSingularSystem if a pivot of the sweep vanishes relative to its row.
+func SolveTridiagonal(lower, diag, upper, rhs []float64) ([]float64, error) {
+	n := len(diag)
+	if n == 0 || len(lower) != n || len(upper) != n || len(rhs) != n {
+		return nil, ErrInvalidSize
+	}
+	ksi, eta := make([]float64, n), make([]float64, n)
+
+	for i := 0; i < n; i++ {
+		denom := diag[i]
+		scale := math.Abs(diag[i]) + math.Abs(upper[i])
+		if i > 0 {
+			denom += lower[i] * ksi[i-1]
+			scale += math.Abs(lower[i])
+		}
+		if math.Abs(denom) <= singular*scale || denom == 0 {
+			return nil, ErrSingularSystem
+		}
+		if i < n-1 {
+			ksi[i] = -upper[i] / denom
+		}
+		eta[i] = rhs[i]
+		if i > 0 {
+			eta[i] -= lower[i] * eta[i-1]
+		}
+		eta[i] /= denom
+	}
+
+	x := make([]float64, n)
+	x[n-1] = eta[n-1]
+	for i := n - 2; i >= 0; i-- {
+		x[i] = ksi[i]*x[i+1] + eta[i]
+	}
+	return x, nil
+}
+
+// SolveCyclicTridiagonal() solves the tridiagonal system with the corner elements
+// lower[0] (row 0, column n-1) and upper[n-1] (row n-1, column 0) by the Sherman-Morrison formula:
+// two systems with the tridiagonal part are solved by SolveTridiagonal().
+// Such systems arise from periodic problems (periodic splines, boundary value problems on a circle).
+func SolveCyclicTridiagonal(lower, diag, upper, rhs []float64) ([]float64, error) {
+	n := len(diag)
+	if n == 0 || len(lower) != n || len(upper) != n || len(rhs) != n {
+		return nil, ErrInvalidSize
+	}
+	switch n {
+	case 1:
+		// All the elements are in the same place.
+		a := diag[0] + lower[0] + upper[0]
+		if a == 0 {
+			return nil, ErrSingularSystem
+		}
+		return []float64{rhs[0] / a}, nil
+	case 2:
+		// Both corner elements are on the side diagonals.
+		a01, a10 := upper[0]+lower[0], lower[1]+upper[1]
+		det := diag[0]*diag[1] - a01*a10
+		if math.Abs(det) <= singular*(math.Abs(diag[0]*diag[1])+math.Abs(a01*a10)) {
+			return nil, ErrSingularSystem
+		}
+		return []float64{
+			(rhs[0]*diag[1] - a01*rhs[1]) / det,
+			(diag[0]*rhs[1] - a10*rhs[0]) / det,
+		}, nil
+	}
+
+	alpha, beta := upper[n-1], lower[0]
+	gamma := -diag[0]
+	if gamma == 0 {
+		gamma = -1
+	}
+
+	modDiag := make([]float64, n)
+	copy(modDiag, diag)
+	modDiag[0] -= gamma
+	modDiag[n-1] -= alpha * beta / gamma
+
+	x, err := SolveTridiagonal(lower, modDiag, upper, rhs)
+	if err != nil {
+		return nil, err
+	}
+	u := make([]float64, n)
+	u[0], u[n-1] = gamma, alpha
+	z, err := SolveTridiagonal(lower, modDiag, upper, u)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := 1 + z[0] + beta*z[n-1]/gamma
+	if math.Abs(denom) <= singular {
+		return nil, ErrSingularSystem
+	}
+	factor := (x[0] + beta*x[n-1]/gamma) / denom
+	for i := 0; i < n; i++ {
+		x[i] -= factor * z[i]
+	}
+	return x, nil
+}
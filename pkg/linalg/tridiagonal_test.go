@@ -0,0 +1,90 @@
+package linalg
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomCyclic() returns the random cyclic system, every row is strictly diagonally dominant.
+func randomCyclic(r *rand.Rand, n int) (lower, diag, upper, rhs []float64) {
+	lower, diag, upper, rhs = make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := 0; i < n; i++ {
+		lower[i], upper[i], rhs[i] = r.Float64()*2-1, r.Float64()*2-1, r.Float64()*2-1
+		diag[i] = math.Copysign(math.Abs(lower[i])+math.Abs(upper[i])+0.1+r.Float64(), r.Float64()-0.5)
+	}
+	return lower, diag, upper, rhs
+}
+
+// dense() returns the dense matrix of the cyclic system, the corner elements are added to the side diagonals for n <= 2.
+func dense(lower, diag, upper []float64) [][]float64 {
+	n := len(diag)
+	a := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		a[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		a[i][i] += diag[i]
+		a[i][(i+n-1)%n] += lower[i]
+		a[i][(i+1)%n] += upper[i]
+	}
+	return a
+}
+
+func TestSolveCyclicTridiagonal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 7, 20} {
+		for trial := 0; trial < 20; trial++ {
+			lower, diag, upper, rhs := randomCyclic(r, n)
+			expected, err := Solve(dense(lower, diag, upper), rhs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := SolveCyclicTridiagonal(lower, diag, upper, rhs)
+			if err != nil {
+				t.Fatalf("n = %d: %v", n, err)
+			}
+			for i := range expected {
+				if math.Abs(got[i]-expected[i]) > 1e-10*(1+math.Abs(expected[i])) {
+					t.Fatalf("n = %d: x = %v, Gaussian elimination %v", n, got, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestCheckDominance(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	lower, diag, upper, _ := randomCyclic(r, 10)
+	if d := CheckDominance(lower, diag, upper, true); !d.Dominant || !d.Strict || d.Margin <= 0 {
+		t.Fatalf("the dominant matrix: %+v", d)
+	}
+
+	// The row 4 is not dominant, the corner elements matter only for the cyclic matrix.
+	diag[4] = math.Abs(lower[4]) + math.Abs(upper[4]) - 0.05
+	if d := CheckDominance(lower, diag, upper, true); d.Dominant || d.Row != 4 || d.Margin >= 0 {
+		t.Fatalf("the row 4 is not dominant: %+v", d)
+	}
+	diag[4] += 0.05
+	if d := CheckDominance(lower, diag, upper, false); !d.Dominant || d.Strict || d.Row != 4 {
+		t.Fatalf("the row 4 is dominant not strictly: %+v", d)
+	}
+
+	lower[0], diag[0] = 1, 0.5
+	if d := CheckDominance(lower, diag, upper, false); !d.Dominant {
+		t.Fatalf("lower[0] is not used without the corners: %+v", d)
+	}
+	if d := CheckDominance(lower, diag, upper, true); d.Dominant {
+		t.Fatalf("lower[0] is the corner element: %+v", d)
+	}
+}
+
+func TestSolveCyclicTridiagonalSingular(t *testing.T) {
+	// The rows sum to zero: the constant vector is in the kernel.
+	lower := []float64{-1, -1, -1, -1, -1}
+	diag := []float64{2, 2, 2, 2, 2}
+	upper := []float64{-1, -1, -1, -1, -1}
+	if _, err := SolveCyclicTridiagonal(lower, diag, upper, []float64{1, 0, 0, 0, -1}); err != ErrSingularSystem {
+		t.Fatalf("the singular system: %v, expected %v", err, ErrSingularSystem)
+	}
+}
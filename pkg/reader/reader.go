@@ -0,0 +1,107 @@
+package reader
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hahaclassic/computational-algorithms.git/pkg/matrix"
+)
+
+var (
+	ErrEmptyFile = errors.New("the file contains no data")
+	ErrNoSlice   = errors.New("the table must start with the row z,<value>")
+)
+
+func ReadCSV(fileName string, separator rune, fieldsPerRecord int) ([][]string, error) {
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comma = separator
+	reader.FieldsPerRecord = fieldsPerRecord
+
+	data, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func ReadCSVFloatMatrix(fileName string, separator rune, fieldsPerRecord int) ([][]float64, error) {
+	strData, err := ReadCSV(fileName, separator, fieldsPerRecord)
+	if err != nil {
+		return nil, err
+	}
+	data, err := matrix.MatrixAtof(strData[1:])
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ReadCSVGrid() reads the table of z(x, y): the first row contains the nodes x, the first column - the nodes y.
+// The corner cell is not used and may contain a label.
+func ReadCSVGrid(fileName string, separator rune) ([][]float64, error) {
+	strData, err := ReadCSV(fileName, separator, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(strData) == 0 {
+		return nil, ErrEmptyFile
+	}
+	strData[0][0] = "0"
+	return matrix.MatrixAtof(strData)
+}
+
+// ReadCSVSlices() reads the table of u(x, y, z) sliced by z. Every slice starts with the row "z,<value>"
+// followed by the table of u(x, y) at this z in the layout of ReadCSVGrid().
+func ReadCSVSlices(fileName string, separator rune) ([]float64, [][][]float64, error) {
+	strData, err := ReadCSV(fileName, separator, -1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var zs []float64
+	var slices [][][]string
+	for i := 0; i < len(strData); i++ {
+		if strings.TrimSpace(strData[i][0]) == "z" && len(strData[i]) == 2 {
+			z, err := strconv.ParseFloat(strings.TrimSpace(strData[i][1]), 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			zs = append(zs, z)
+			slices = append(slices, nil)
+			if i+1 < len(strData) {
+				strData[i+1][0] = "0"
+			}
+			continue
+		}
+		if len(slices) == 0 {
+			return nil, nil, ErrNoSlice
+		}
+		slices[len(slices)-1] = append(slices[len(slices)-1], strData[i])
+	}
+	if len(slices) == 0 {
+		return nil, nil, ErrEmptyFile
+	}
+
+	result := make([][][]float64, len(slices))
+	for k := 0; k < len(slices); k++ {
+		result[k], err = matrix.MatrixAtof(slices[k])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return zs, result, nil
+}